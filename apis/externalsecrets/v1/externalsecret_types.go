@@ -224,6 +224,61 @@ type ExternalSecretTarget struct {
 	// Immutable defines if the final secret will be immutable
 	// +optional
 	Immutable bool `json:"immutable,omitempty"`
+
+	// Versioning creates a new immutable Secret named <name>-<hash> for every change of the data,
+	// instead of updating the Secret in place. Requires creationPolicy=Owner.
+	// +optional
+	Versioning *ExternalSecretTargetVersioning `json:"versioning,omitempty"`
+
+	// History keeps the last revisions of the synced data in Secrets owned by the ExternalSecret,
+	// which the target Secret can be rolled back to with the rollback annotation.
+	// +optional
+	History *ExternalSecretTargetHistory `json:"history,omitempty"`
+
+	// Rollout defines the workloads which are rolled out when the data of the Secret changes.
+	// The data hash of the Secret is written to an annotation of their pod template.
+	// +optional
+	Rollout []ExternalSecretRolloutTarget `json:"rollout,omitempty"`
+}
+
+// ExternalSecretTargetHistory defines how many revisions of the synced data are kept.
+type ExternalSecretTargetHistory struct {
+	// Limit is the number of revisions which are kept, including the current revision.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	Limit *int32 `json:"limit,omitempty"`
+}
+
+// ExternalSecretTargetVersioning defines how the versions of the target Secret are kept.
+type ExternalSecretTargetVersioning struct {
+	// Retain is the number of previous versions which are kept in addition to the current version.
+	// Older versions are deleted.
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Retain *int32 `json:"retain,omitempty"`
+
+	// Alias keeps a mutable Secret with the name of the target which always has the data of the current version.
+	// +optional
+	Alias bool `json:"alias,omitempty"`
+}
+
+// ExternalSecretRolloutTarget selects workloads in the namespace of the ExternalSecret
+// by name or by label selector.
+type ExternalSecretRolloutTarget struct {
+	// Kind of the workloads.
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet;DaemonSet
+	Kind string `json:"kind"`
+
+	// Name of the workload.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selector selects the workloads by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 // ExternalSecretData defines the connection between the Kubernetes Secret key (spec.data.<key>) and the Provider data.
@@ -241,8 +296,34 @@ type ExternalSecretData struct {
 	// SourceRef allows you to override the source
 	// from which the value will be pulled.
 	SourceRef *StoreSourceRef `json:"sourceRef,omitempty"`
+
+	// OnError defines what happens if the entry can not be fetched.
+	// Defaults to "Fail"
+	// +optional
+	OnError ExternalSecretOnError `json:"onError,omitempty"`
+
+	// Default is the value that is stored if the entry can not be fetched and onError is UseDefault.
+	// +optional
+	Default *string `json:"default,omitempty"`
 }
 
+// ExternalSecretOnError defines what happens if a data entry can not be fetched.
+// Only errors of the entry itself are handled, errors of the store like an outage of the provider
+// or an open circuit breaker always fail the sync.
+// +kubebuilder:validation:Enum=Fail;Ignore;UseDefault
+type ExternalSecretOnError string
+
+const (
+	// OnErrorFail fails the sync, unless the spec.failurePolicy handles the error.
+	OnErrorFail ExternalSecretOnError = "Fail"
+
+	// OnErrorIgnore skips the entry and syncs all other entries.
+	OnErrorIgnore ExternalSecretOnError = "Ignore"
+
+	// OnErrorUseDefault stores the default value of the entry and syncs all other entries.
+	OnErrorUseDefault ExternalSecretOnError = "UseDefault"
+)
+
 // ExternalSecretDataRemoteRef defines Provider data location.
 type ExternalSecretDataRemoteRef struct {
 	// Key is the key used in the Provider, mandatory
@@ -333,6 +414,15 @@ type ExternalSecretDataFromRemoteRef struct {
 	// When sourceRef points to a generator Extract or Find is not supported.
 	// The generator returns a static map of values
 	SourceRef *StoreGeneratorSourceRef `json:"sourceRef,omitempty"`
+
+	// OnError defines what happens if the entry can not be fetched.
+	// Defaults to "Fail"
+	// +optional
+	OnError ExternalSecretOnError `json:"onError,omitempty"`
+
+	// Default are the values that are stored if the entry can not be fetched and onError is UseDefault.
+	// +optional
+	Default map[string]string `json:"default,omitempty"`
 }
 
 // ExternalSecretRewrite defines how to rewrite secret data values before they are written to the Secret.
@@ -477,6 +567,23 @@ const (
 	RefreshPolicyOnChange ExternalSecretRefreshPolicy = "OnChange"
 )
 
+// ExternalSecretFailurePolicy defines how failures to fetch single data entries are handled.
+// +kubebuilder:validation:Enum=Fail;KeepLastKnownGood
+type ExternalSecretFailurePolicy string
+
+const (
+	// FailurePolicyFail fails the sync if any entry can not be fetched.
+	// Keys of provider secrets that do not exist are removed according to the deletionPolicy.
+	FailurePolicyFail ExternalSecretFailurePolicy = "Fail"
+
+	// FailurePolicyKeepLastKnownGood keeps the previously fetched values of the spec.data and spec.dataFrom entries
+	// that can not be fetched, including provider secrets that do not exist, and syncs the other entries.
+	// The fetched values are stored in a Secret named <name>-last-known-good owned by the ExternalSecret.
+	// The kept keys are reported in status.staleKeys.
+	// Entries without previously fetched values are handled like with the Fail policy.
+	FailurePolicyKeepLastKnownGood ExternalSecretFailurePolicy = "KeepLastKnownGood"
+)
+
 // ExternalSecretSpec defines the desired state of ExternalSecret.
 type ExternalSecretSpec struct {
 	// +optional
@@ -502,6 +609,11 @@ type ExternalSecretSpec struct {
 	// +kubebuilder:default="1h"
 	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
 
+	// FailurePolicy defines how failures to fetch single data entries are handled.
+	// Defaults to "Fail"
+	// +optional
+	FailurePolicy ExternalSecretFailurePolicy `json:"failurePolicy,omitempty"`
+
 	// Data defines the connection between the Kubernetes Secret keys and the Provider data
 	// +optional
 	Data []ExternalSecretData `json:"data,omitempty"`
@@ -549,7 +661,7 @@ type GeneratorRef struct {
 	APIVersion string `json:"apiVersion,omitempty"`
 
 	// Specify the Kind of the generator resource
-	// +kubebuilder:validation:Enum=ACRAccessToken;ClusterGenerator;CloudsmithAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;MFA
+	// +kubebuilder:validation:Enum=ACRAccessToken;ClusterGenerator;CloudsmithAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;MFA;DatabaseUser;ServiceAccountToken;JWT;AWSIAMAccessKey;GCPServiceAccountKey;AzureApplicationSecret;RegistryCredentials;Composite
 	Kind string `json:"kind"`
 
 	// Specify the name of the generator resource
@@ -557,6 +669,43 @@ type GeneratorRef struct {
 	// +kubebuilder:validation:MaxLength:=253
 	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
 	Name string `json:"name"`
+
+	// MinRegenerationInterval is the minimum time between two calls to the generator.
+	// Until it has passed, the values generated last are re-used, even if the generator manifest changed.
+	// Requires the generator state to be enabled on the controller.
+	// Only used by ExternalSecrets and PushSecrets.
+	// +optional
+	MinRegenerationInterval *metav1.Duration `json:"minRegenerationInterval,omitempty"`
+
+	// MaxAge is the time the values generated last are re-used for,
+	// as long as the generator manifest did not change.
+	// Requires the generator state to be enabled on the controller.
+	// Only used by ExternalSecrets and PushSecrets.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// StateRetention defines how many states of the generator are kept
+	// and when they are garbage collected.
+	// Requires the generator state to be enabled on the controller.
+	// Only used by ExternalSecrets and PushSecrets.
+	// +optional
+	StateRetention *GeneratorStateRetention `json:"stateRetention,omitempty"`
+}
+
+// GeneratorStateRetention defines the retention policy of generator states.
+type GeneratorStateRetention struct {
+	// KeepLast is the number of states that are kept, including the current one.
+	// Older states are flagged for garbage collection when a new state is created.
+	// Defaults to 2, which keeps the previous state alive until the next rotation.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// GCGracePeriod is the time after which a state is cleaned up
+	// once it has been flagged for garbage collection.
+	// Defaults to the --generator-gc-grace-period flag of the controller.
+	// +optional
+	GCGracePeriod *metav1.Duration `json:"gcGracePeriod,omitempty"`
 }
 
 // ExternalSecretConditionType defines a value type for ExternalSecret conditions.
@@ -590,6 +739,8 @@ const (
 	ConditionReasonSecretSynced = "SecretSynced"
 	// ConditionReasonSecretSyncedError indicates that there was an error syncing the secret.
 	ConditionReasonSecretSyncedError = "SecretSyncedError"
+	// ConditionReasonStoreUnavailable indicates that the secret was not synced because the circuit breaker of the store is open.
+	ConditionReasonStoreUnavailable = "StoreUnavailable"
 	// ConditionReasonSecretDeleted indicates that the secret has been deleted.
 	ConditionReasonSecretDeleted = "SecretDeleted"
 	// ConditionReasonSecretMissing indicates that the secret is missing.
@@ -607,6 +758,14 @@ const (
 	ReasonDeleted = "Deleted"
 	// ReasonMissingProviderSecret indicates that the provider secret is missing.
 	ReasonMissingProviderSecret = "MissingProviderSecret"
+	// ReasonStaleData indicates that keys keep their last known good value because they could not be fetched.
+	ReasonStaleData = "StaleData"
+	// ReasonSkippedData indicates that entries were skipped or replaced by their default because they could not be fetched.
+	ReasonSkippedData = "SkippedData"
+	// ReasonRolledOut indicates that the workloads consuming the secret were rolled out because its data changed.
+	ReasonRolledOut = "RolledOut"
+	// ReasonRolledBack indicates that the secret was pinned to a revision of its history.
+	ReasonRolledBack = "RolledBack"
 )
 
 // ExternalSecretStatus defines the observed state of ExternalSecret.
@@ -619,11 +778,119 @@ type ExternalSecretStatus struct {
 	// SyncedResourceVersion keeps track of the last synced version
 	SyncedResourceVersion string `json:"syncedResourceVersion,omitempty"`
 
+	// RefreshRequestedAt is set by the refresh receiver when a remote key of the ExternalSecret changed.
+	// The ExternalSecret is refreshed and the field is cleared with the next sync.
+	// +optional
+	RefreshRequestedAt *metav1.Time `json:"refreshRequestedAt,omitempty"`
+
 	// +optional
 	Conditions []ExternalSecretStatusCondition `json:"conditions,omitempty"`
 
 	// Binding represents a servicebinding.io Provisioned Service reference to the secret
 	Binding corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// GeneratorStates lists the generator states that are currently owned by the ExternalSecret.
+	// +optional
+	GeneratorStates []GeneratorStateSummary `json:"generatorStates,omitempty"`
+
+	// StaleKeys lists the keys of the target Secret which could not be fetched
+	// and keep their last known good value, see spec.failurePolicy.
+	// +optional
+	StaleKeys []ExternalSecretStaleKey `json:"staleKeys,omitempty"`
+
+	// SkippedData lists the data entries which could not be fetched
+	// and were handled according to their onError policy.
+	// +optional
+	SkippedData []ExternalSecretSkippedData `json:"skippedData,omitempty"`
+
+	// CurrentSecretName is the name of the current version of the target Secret, see spec.target.versioning.
+	// +optional
+	CurrentSecretName string `json:"currentSecretName,omitempty"`
+
+	// RolloutDataHash is the data hash of the Secret the workloads of spec.target.rollout were rolled out with.
+	// +optional
+	RolloutDataHash string `json:"rolloutDataHash,omitempty"`
+
+	// Revisions lists the revisions of the synced data kept in the history, oldest first, see spec.target.history.
+	// +optional
+	Revisions []ExternalSecretRevision `json:"revisions,omitempty"`
+
+	// PinnedRevision is the revision the target Secret is rolled back to with the rollback annotation.
+	// +optional
+	PinnedRevision *int64 `json:"pinnedRevision,omitempty"`
+}
+
+// ExternalSecretRevision describes a revision of the synced data.
+type ExternalSecretRevision struct {
+	// Revision is the number of the revision, starting at 1.
+	Revision int64 `json:"revision"`
+
+	// SecretName is the name of the Secret which holds the data of the revision.
+	SecretName string `json:"secretName"`
+
+	// DataHash is the hash of the data of the revision.
+	DataHash string `json:"dataHash"`
+
+	// CreatedAt is the time the revision was synced.
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// SourceVersions are the versions of the provider secrets by secret key, as reported by the provider
+	// for spec.data and spec.dataFrom[].extract entries. Keys without a known version are left out.
+	// +optional
+	SourceVersions map[string]string `json:"sourceVersions,omitempty"`
+}
+
+// ExternalSecretSkippedData describes a data entry which could not be fetched.
+type ExternalSecretSkippedData struct {
+	// Path of the entry, e.g. spec.data[0].
+	Path string `json:"path"`
+
+	// OnError is the policy that was applied to the entry.
+	OnError ExternalSecretOnError `json:"onError"`
+
+	// Message is the error of the entry.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ExternalSecretStaleKey describes a key of the target Secret which keeps its last known good value.
+type ExternalSecretStaleKey struct {
+	// SecretKey is the key of the target Secret.
+	SecretKey string `json:"secretKey"`
+
+	// Since is the time the key could not be fetched for the first time.
+	Since metav1.Time `json:"since"`
+
+	// Message is the error of the last attempt to fetch the key.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GeneratorStateSummary describes a generator state owned by an ExternalSecret or PushSecret.
+type GeneratorStateSummary struct {
+	// Key identifies the generator within the owning resource,
+	// e.g. the index in spec.dataFrom of an ExternalSecret.
+	Key string `json:"key"`
+
+	// Name of the GeneratorState resource.
+	Name string `json:"name"`
+
+	// CreationTimestamp is the time the state was created.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+
+	// GarbageCollectionDeadline is the time after which the state is cleaned up.
+	// It is not set for states that are still in use.
+	// +optional
+	GarbageCollectionDeadline *metav1.Time `json:"garbageCollectionDeadline,omitempty"`
+
+	// Ready is the status of the Ready condition of the state.
+	// It is False if the state could not be cleaned up.
+	// +optional
+	Ready corev1.ConditionStatus `json:"ready,omitempty"`
+
+	// Message of the Ready condition of the state.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ExternalSecret is the Schema for the external-secrets API.
@@ -655,6 +922,14 @@ const (
 	AnnotationDataHash = "reconcile.external-secrets.io/data-hash"
 	// AnnotationForceSync all ExternalSecrets managed by a ClusterExternalSecret mirror the state and value of this annotation.
 	AnnotationForceSync = "external-secrets.io/force-sync"
+	// AnnotationRolloutPrefix is followed by the name of the secret in the pod template annotations of the workloads
+	// which are rolled out when the data of the secret changes, see spec.target.rollout. The value is the data hash of the secret.
+	AnnotationRolloutPrefix = "rollout.external-secrets.io/"
+	// AnnotationRollbackRevision pins the target secret to a revision of the history of an ExternalSecret, see spec.target.history.
+	// The provider is not queried while the annotation is set.
+	AnnotationRollbackRevision = "external-secrets.io/rollback-revision"
+	// AnnotationRevision is the revision of the data of a history secret.
+	AnnotationRevision = "reconcile.external-secrets.io/revision"
 
 	// LabelManaged all secrets managed by an ExternalSecret will have this label equal to "true".
 	LabelManaged = "reconcile.external-secrets.io/managed"
@@ -664,6 +939,16 @@ const (
 
 	// LabelOwner points to the owning ExternalSecret resource when CreationPolicy=Owner.
 	LabelOwner = "reconcile.external-secrets.io/created-by"
+
+	// LabelLastKnownGoodOf points to the owning ExternalSecret resource of the secret which holds its last known good values,
+	// see spec.failurePolicy.
+	LabelLastKnownGoodOf = "reconcile.external-secrets.io/last-known-good-of"
+
+	// LabelHistoryOf points to the owning ExternalSecret resource of the history secrets, see spec.target.history.
+	LabelHistoryOf = "reconcile.external-secrets.io/history-of"
+
+	// LabelVersionOf points to the owning ExternalSecret resource of the versions of the target secret, see spec.target.versioning.
+	LabelVersionOf = "reconcile.external-secrets.io/version-of"
 )
 
 // +kubebuilder:object:root=true
@@ -73,12 +73,43 @@ func validateExternalSecret(obj runtime.Object) (admission.Warnings, error) {
 		if err := validateSourceRef(ref); err != nil {
 			errs = errors.Join(errs, err)
 		}
+
+		if err := validateOnError(ref.OnError, ref.Default != nil); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	for _, ref := range es.Spec.Data {
+		if err := validateOnError(ref.OnError, ref.Default != nil); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("secretKey %s: %w", ref.SecretKey, err))
+		}
+	}
+
+	if es.Spec.Target.Versioning != nil && es.Spec.Target.CreationPolicy != "" && es.Spec.Target.CreationPolicy != CreatePolicyOwner {
+		errs = errors.Join(errs, errors.New("versioning requires creationPolicy=Owner"))
+	}
+
+	for i, target := range es.Spec.Target.Rollout {
+		if (target.Name == "") == (target.Selector == nil) {
+			errs = errors.Join(errs, fmt.Errorf("spec.target.rollout[%d]: either name or selector must be set", i))
+		}
 	}
 
 	errs = validateDuplicateKeys(es, errs)
 	return nil, errs
 }
 
+func validateOnError(onError ExternalSecretOnError, hasDefault bool) error {
+	if onError == OnErrorUseDefault && !hasDefault {
+		return errors.New("default must be set when using onError=UseDefault")
+	}
+	if onError != OnErrorUseDefault && hasDefault {
+		return errors.New("default can only be used with onError=UseDefault")
+	}
+
+	return nil
+}
+
 func validateSourceRef(ref ExternalSecretDataFromRemoteRef) error {
 	if ref.SourceRef != nil && ref.SourceRef.GeneratorRef == nil && ref.SourceRef.SecretStoreRef == nil {
 		return errors.New("generatorRef or storeRef must be set when using sourceRef in dataFrom")
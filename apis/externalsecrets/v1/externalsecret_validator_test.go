@@ -19,7 +19,9 @@ package v1
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -204,6 +206,82 @@ either data or dataFrom should be specified`,
 			},
 			expectedErr: "duplicate secretKey found: SERVICE_NAME",
 		},
+		{
+			name: "onError UseDefault with default",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					Data: []ExternalSecretData{
+						{SecretKey: "SERVICE_NAME", OnError: OnErrorUseDefault, Default: ptr.To("")},
+					},
+					DataFrom: []ExternalSecretDataFromRemoteRef{
+						{
+							Extract: &ExternalSecretDataRemoteRef{Key: "key"},
+							OnError: OnErrorUseDefault,
+							Default: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "onError UseDefault without default",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					Data: []ExternalSecretData{
+						{SecretKey: "SERVICE_NAME", OnError: OnErrorUseDefault},
+					},
+				},
+			},
+			expectedErr: "secretKey SERVICE_NAME: default must be set when using onError=UseDefault",
+		},
+		{
+			name: "default without onError UseDefault",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					DataFrom: []ExternalSecretDataFromRemoteRef{
+						{
+							Extract: &ExternalSecretDataRemoteRef{Key: "key"},
+							OnError: OnErrorIgnore,
+							Default: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			},
+			expectedErr: "default can only be used with onError=UseDefault",
+		},
+		{
+			name: "versioning with creationPolicy Orphan",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					Target: ExternalSecretTarget{
+						CreationPolicy: CreatePolicyOrphan,
+						Versioning:     &ExternalSecretTargetVersioning{},
+					},
+					Data: []ExternalSecretData{
+						{SecretKey: "SERVICE_NAME"},
+					},
+				},
+			},
+			expectedErr: "versioning requires creationPolicy=Owner",
+		},
+		{
+			name: "rollout with name and selector",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					Target: ExternalSecretTarget{
+						Rollout: []ExternalSecretRolloutTarget{
+							{Kind: "Deployment", Name: "app"},
+							{Kind: "Deployment", Name: "app", Selector: &metav1.LabelSelector{}},
+							{Kind: "StatefulSet"},
+						},
+					},
+					Data: []ExternalSecretData{
+						{SecretKey: "SERVICE_NAME"},
+					},
+				},
+			},
+			expectedErr: "spec.target.rollout[1]: either name or selector must be set\nspec.target.rollout[2]: either name or selector must be set",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
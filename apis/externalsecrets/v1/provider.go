@@ -99,6 +99,19 @@ type SecretsClient interface {
 	Close(ctx context.Context) error
 }
 
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// SecretVersionGetter can be implemented by a SecretsClient to expose the versions of the provider secrets.
+// The versions are recorded in the revision history of an ExternalSecret.
+type SecretVersionGetter interface {
+	// GetSecretVersion returns the version of the provider secret which was returned by GetSecret or GetSecretMap
+	// for the same ref, or an empty string if the version is not known.
+	GetSecretVersion(ctx context.Context, ref ExternalSecretDataRemoteRef) (string, error)
+}
+
 // NoSecretErr is a sentinel error for when a secret is not found.
 var NoSecretErr = NoSecretError{}
 
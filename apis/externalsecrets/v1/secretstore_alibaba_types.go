@@ -49,4 +49,29 @@ type AlibabaProvider struct {
 	Auth AlibabaAuth `json:"auth"`
 	// Alibaba Region to be used for the provider
 	RegionID string `json:"regionID"`
+
+	// SecretsManager defines how the provider behaves when interacting with Alibaba Secrets Manager
+	// +optional
+	SecretsManager *AlibabaSecretsManager `json:"secretsManager,omitempty"`
+}
+
+// AlibabaSecretsManager defines how the provider behaves when interacting with Alibaba Secrets Manager.
+// Some of these settings are only applicable to controlling how secrets are deleted,
+// and hence only apply to PushSecret (with deletionPolicy=Delete).
+type AlibabaSecretsManager struct {
+	// Specifies whether to delete the secret without any recovery window. You
+	// can't use both this parameter and RecoveryWindowInDays in the same call.
+	// If you don't use either, then by default Secrets Manager uses a 30 day
+	// recovery window.
+	// see: https://www.alibabacloud.com/help/en/kms/developer-reference/api-kms-2016-01-20-deletesecret
+	// +optional
+	ForceDeleteWithoutRecovery bool `json:"forceDeleteWithoutRecovery,omitempty"`
+	// The number of days from 7 to 30 that Secrets Manager waits before
+	// permanently deleting the secret. You can't use both this parameter and
+	// ForceDeleteWithoutRecovery in the same call. If you don't use either,
+	// then by default Secrets Manager uses a 30 day recovery window.
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	RecoveryWindowInDays int64 `json:"recoveryWindowInDays,omitempty"`
 }
@@ -316,6 +316,50 @@ type SecretStoreStatus struct {
 	Conditions []SecretStoreStatusCondition `json:"conditions,omitempty"`
 	// +optional
 	Capabilities SecretStoreCapabilities `json:"capabilities,omitempty"`
+	// CircuitBreaker is the state of the circuit breaker of the controller replica which reconciles the store.
+	// Every replica has its own circuit breaker per store, which only counts its own requests,
+	// so the state of other replicas may differ.
+	// It is only set if the circuit breaker is enabled in the controller.
+	// +optional
+	CircuitBreaker *SecretStoreCircuitBreakerStatus `json:"circuitBreaker,omitempty"`
+}
+
+// SecretStoreCircuitBreakerState is the state of the circuit breaker of a store.
+// +kubebuilder:validation:Enum=Closed;Open;HalfOpen
+type SecretStoreCircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed allows all requests towards the provider.
+	CircuitBreakerClosed SecretStoreCircuitBreakerState = "Closed"
+	// CircuitBreakerOpen rejects all requests towards the provider.
+	CircuitBreakerOpen SecretStoreCircuitBreakerState = "Open"
+	// CircuitBreakerHalfOpen allows a single request to probe whether the provider recovered.
+	CircuitBreakerHalfOpen SecretStoreCircuitBreakerState = "HalfOpen"
+)
+
+// SecretStoreCircuitBreakerStatus describes the state of the circuit breaker of a store in a controller replica.
+type SecretStoreCircuitBreakerStatus struct {
+	State SecretStoreCircuitBreakerState `json:"state"`
+
+	// Replica is the name of the controller replica the state was observed by.
+	// +optional
+	Replica string `json:"replica,omitempty"`
+
+	// ConsecutiveFailures is the number of requests towards the provider which failed in a row
+	// because the provider was unavailable.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// RetryTime is the time when the open circuit breaker allows the next request to probe the provider.
+	// +optional
+	RetryTime *metav1.Time `json:"retryTime,omitempty"`
+
+	// Message is the error of the last failed request.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -280,6 +280,11 @@ func (in *AlibabaAuthSecretRef) DeepCopy() *AlibabaAuthSecretRef {
 func (in *AlibabaProvider) DeepCopyInto(out *AlibabaProvider) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.SecretsManager != nil {
+		in, out := &in.SecretsManager, &out.SecretsManager
+		*out = new(AlibabaSecretsManager)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlibabaProvider.
@@ -307,6 +312,21 @@ func (in *AlibabaRRSAAuth) DeepCopy() *AlibabaRRSAAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlibabaSecretsManager) DeepCopyInto(out *AlibabaSecretsManager) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlibabaSecretsManager.
+func (in *AlibabaSecretsManager) DeepCopy() *AlibabaSecretsManager {
+	if in == nil {
+		return nil
+	}
+	out := new(AlibabaSecretsManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationProtocol) DeepCopyInto(out *AuthorizationProtocol) {
 	*out = *in
@@ -1368,6 +1388,11 @@ func (in *ExternalSecretData) DeepCopyInto(out *ExternalSecretData) {
 		*out = new(StoreSourceRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretData.
@@ -1405,6 +1430,13 @@ func (in *ExternalSecretDataFromRemoteRef) DeepCopyInto(out *ExternalSecretDataF
 		*out = new(StoreGeneratorSourceRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretDataFromRemoteRef.
@@ -1525,6 +1557,29 @@ func (in *ExternalSecretMetadata) DeepCopy() *ExternalSecretMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretRevision) DeepCopyInto(out *ExternalSecretRevision) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.SourceVersions != nil {
+		in, out := &in.SourceVersions, &out.SourceVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretRevision.
+func (in *ExternalSecretRevision) DeepCopy() *ExternalSecretRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecretRewrite) DeepCopyInto(out *ExternalSecretRewrite) {
 	*out = *in
@@ -1605,6 +1660,41 @@ func (in *ExternalSecretRewriteTransform) DeepCopy() *ExternalSecretRewriteTrans
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretRolloutTarget) DeepCopyInto(out *ExternalSecretRolloutTarget) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretRolloutTarget.
+func (in *ExternalSecretRolloutTarget) DeepCopy() *ExternalSecretRolloutTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretRolloutTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretSkippedData) DeepCopyInto(out *ExternalSecretSkippedData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSkippedData.
+func (in *ExternalSecretSkippedData) DeepCopy() *ExternalSecretSkippedData {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretSkippedData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecretSpec) DeepCopyInto(out *ExternalSecretSpec) {
 	*out = *in
@@ -1641,10 +1731,30 @@ func (in *ExternalSecretSpec) DeepCopy() *ExternalSecretSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretStaleKey) DeepCopyInto(out *ExternalSecretStaleKey) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretStaleKey.
+func (in *ExternalSecretStaleKey) DeepCopy() *ExternalSecretStaleKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretStaleKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecretStatus) DeepCopyInto(out *ExternalSecretStatus) {
 	*out = *in
 	in.RefreshTime.DeepCopyInto(&out.RefreshTime)
+	if in.RefreshRequestedAt != nil {
+		in, out := &in.RefreshRequestedAt, &out.RefreshRequestedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]ExternalSecretStatusCondition, len(*in))
@@ -1653,6 +1763,37 @@ func (in *ExternalSecretStatus) DeepCopyInto(out *ExternalSecretStatus) {
 		}
 	}
 	out.Binding = in.Binding
+	if in.GeneratorStates != nil {
+		in, out := &in.GeneratorStates, &out.GeneratorStates
+		*out = make([]GeneratorStateSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StaleKeys != nil {
+		in, out := &in.StaleKeys, &out.StaleKeys
+		*out = make([]ExternalSecretStaleKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SkippedData != nil {
+		in, out := &in.SkippedData, &out.SkippedData
+		*out = make([]ExternalSecretSkippedData, len(*in))
+		copy(*out, *in)
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ExternalSecretRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PinnedRevision != nil {
+		in, out := &in.PinnedRevision, &out.PinnedRevision
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretStatus.
@@ -1689,6 +1830,23 @@ func (in *ExternalSecretTarget) DeepCopyInto(out *ExternalSecretTarget) {
 		*out = new(ExternalSecretTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Versioning != nil {
+		in, out := &in.Versioning, &out.Versioning
+		*out = new(ExternalSecretTargetVersioning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(ExternalSecretTargetHistory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = make([]ExternalSecretRolloutTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretTarget.
@@ -1701,6 +1859,46 @@ func (in *ExternalSecretTarget) DeepCopy() *ExternalSecretTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretTargetHistory) DeepCopyInto(out *ExternalSecretTargetHistory) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretTargetHistory.
+func (in *ExternalSecretTargetHistory) DeepCopy() *ExternalSecretTargetHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretTargetHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretTargetVersioning) DeepCopyInto(out *ExternalSecretTargetVersioning) {
+	*out = *in
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretTargetVersioning.
+func (in *ExternalSecretTargetVersioning) DeepCopy() *ExternalSecretTargetVersioning {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretTargetVersioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecretTemplate) DeepCopyInto(out *ExternalSecretTemplate) {
 	*out = *in
@@ -2044,6 +2242,21 @@ func (in *GcpIamAuthCredentials) DeepCopy() *GcpIamAuthCredentials {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeneratorRef) DeepCopyInto(out *GeneratorRef) {
 	*out = *in
+	if in.MinRegenerationInterval != nil {
+		in, out := &in.MinRegenerationInterval, &out.MinRegenerationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StateRetention != nil {
+		in, out := &in.StateRetention, &out.StateRetention
+		*out = new(GeneratorStateRetention)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorRef.
@@ -2056,6 +2269,51 @@ func (in *GeneratorRef) DeepCopy() *GeneratorRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorStateRetention) DeepCopyInto(out *GeneratorStateRetention) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GCGracePeriod != nil {
+		in, out := &in.GCGracePeriod, &out.GCGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorStateRetention.
+func (in *GeneratorStateRetention) DeepCopy() *GeneratorStateRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorStateRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorStateSummary) DeepCopyInto(out *GeneratorStateSummary) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	if in.GarbageCollectionDeadline != nil {
+		in, out := &in.GarbageCollectionDeadline, &out.GarbageCollectionDeadline
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorStateSummary.
+func (in *GeneratorStateSummary) DeepCopy() *GeneratorStateSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorStateSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericStoreValidator) DeepCopyInto(out *GenericStoreValidator) {
 	*out = *in
@@ -3161,6 +3419,26 @@ func (in *SecretStore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretStoreCircuitBreakerStatus) DeepCopyInto(out *SecretStoreCircuitBreakerStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.RetryTime != nil {
+		in, out := &in.RetryTime, &out.RetryTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreCircuitBreakerStatus.
+func (in *SecretStoreCircuitBreakerStatus) DeepCopy() *SecretStoreCircuitBreakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretStoreCircuitBreakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretStoreList) DeepCopyInto(out *SecretStoreList) {
 	*out = *in
@@ -3480,6 +3758,11 @@ func (in *SecretStoreStatus) DeepCopyInto(out *SecretStoreStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(SecretStoreCircuitBreakerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreStatus.
@@ -3566,7 +3849,7 @@ func (in *StoreGeneratorSourceRef) DeepCopyInto(out *StoreGeneratorSourceRef) {
 	if in.GeneratorRef != nil {
 		in, out := &in.GeneratorRef, &out.GeneratorRef
 		*out = new(GeneratorRef)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -3587,7 +3870,7 @@ func (in *StoreSourceRef) DeepCopyInto(out *StoreSourceRef) {
 	if in.GeneratorRef != nil {
 		in, out := &in.GeneratorRef, &out.GeneratorRef
 		*out = new(GeneratorRef)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
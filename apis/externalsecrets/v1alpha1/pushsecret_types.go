@@ -247,6 +247,9 @@ type PushSecretStatus struct {
 	SyncedPushSecrets SyncedPushSecretsMap `json:"syncedPushSecrets,omitempty"`
 	// +optional
 	Conditions []PushSecretStatusCondition `json:"conditions,omitempty"`
+	// GeneratorStates lists the generator states that are currently owned by the PushSecret.
+	// +optional
+	GeneratorStates []esv1.GeneratorStateSummary `json:"generatorStates,omitempty"`
 }
 
 // +kubebuilder:object:root=true
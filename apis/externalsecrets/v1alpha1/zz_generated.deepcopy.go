@@ -362,7 +362,7 @@ func (in *PushSecretSelector) DeepCopyInto(out *PushSecretSelector) {
 	if in.GeneratorRef != nil {
 		in, out := &in.GeneratorRef, &out.GeneratorRef
 		*out = new(externalsecretsv1.GeneratorRef)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -445,6 +445,13 @@ func (in *PushSecretStatus) DeepCopyInto(out *PushSecretStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GeneratorStates != nil {
+		in, out := &in.GeneratorStates, &out.GeneratorStates
+		*out = make([]externalsecretsv1.GeneratorStateSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushSecretStatus.
@@ -53,5 +53,28 @@ type Generator interface {
 	) error
 }
 
+// Renewer is an optional interface that can be implemented by generators
+// whose generated values can be renewed in place instead of being generated again.
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+type Renewer interface {
+	// RenewEnabled reports whether renewal is enabled for the given generator manifest.
+	// Generated values are only kept for later re-use if it returns true.
+	RenewEnabled(obj *apiextensions.JSON) bool
+
+	// Renew extends the lifetime of the values tracked by the given state.
+	// It returns the updated state and true if the previously generated values
+	// are still valid, or false if new values must be generated.
+	Renew(
+		ctx context.Context,
+		obj *apiextensions.JSON,
+		status GeneratorProviderState,
+		kube client.Client,
+		namespace string,
+	) (GeneratorProviderState, bool, error)
+}
+
 // GeneratorProviderState represents the state of a generator provider that can be stored and retrieved.
 type GeneratorProviderState *apiextensions.JSON
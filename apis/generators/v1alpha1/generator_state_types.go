@@ -38,6 +38,18 @@ const (
 	// It is used in the garbage collection process to identify all states
 	// that belong to a specific resource.
 	GeneratorStateLabelOwnerKey = "generators.external-secrets.io/owner-key"
+
+	// GeneratorStateAnnotationForceCleanup forces the garbage collection of a generator state when set to "true".
+	// The state is deleted immediately and the finalizer is removed even if the
+	// generator fails to clean up the state, e.g. because the upstream system is unavailable.
+	GeneratorStateAnnotationForceCleanup = "generators.external-secrets.io/force-cleanup"
+
+	// GeneratorStateLabelOutput marks the Secrets which store the generated values of a generator state
+	// for re-use. They contain plain credentials and can be selected with this label,
+	// e.g. to exclude them from backups or to audit them.
+	GeneratorStateLabelOutput = "generators.external-secrets.io/output"
+	// GeneratorStateLabelOutputValue is the value of GeneratorStateLabelOutput.
+	GeneratorStateLabelOutputValue = "true"
 )
 
 // GeneratorStateSpec defines the desired state of a generator state resource.
@@ -56,7 +68,10 @@ type GeneratorStateSpec struct {
 	// be blocked by a finalizer.
 	Resource *apiextensions.JSON `json:"resource"`
 	// State is the state that was produced by the generator implementation.
-	State *apiextensions.JSON `json:"state"`
+	// It is empty for generators that do not produce a state, if the
+	// GeneratorState only keeps the generated values for re-use.
+	// +optional
+	State *apiextensions.JSON `json:"state,omitempty"`
 }
 
 // GeneratorStateConditionType represents the type of condition for a generator state.
@@ -95,9 +110,13 @@ type GeneratorStateStatus struct {
 }
 
 // GeneratorState represents the state created and managed by a generator resource.
+// If the generated values are re-used, e.g. because of maxAge, minRegenerationInterval or lease renewal,
+// they are stored in plain text in a Secret with the same name which is owned by the GeneratorState
+// and labeled with generators.external-secrets.io/output=true.
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="GC Deadline",type="string",JSONPath=".spec.garbageCollectionDeadline"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators},shortName=gs
@@ -73,6 +73,22 @@ var (
 	ClusterGeneratorKind = reflect.TypeOf(ClusterGenerator{}).Name()
 	// CloudsmithAccessTokenKind is the kind name for CloudsmithAccessToken resource.
 	CloudsmithAccessTokenKind = reflect.TypeOf(CloudsmithAccessToken{}).Name()
+	// DatabaseUserKind is the kind name for DatabaseUser resource.
+	DatabaseUserKind = reflect.TypeOf(DatabaseUser{}).Name()
+	// ServiceAccountTokenKind is the kind name for ServiceAccountToken resource.
+	ServiceAccountTokenKind = reflect.TypeOf(ServiceAccountToken{}).Name()
+	// JWTKind is the kind name for JWT resource.
+	JWTKind = reflect.TypeOf(JWT{}).Name()
+	// AWSIAMAccessKeyKind is the kind name for AWSIAMAccessKey resource.
+	AWSIAMAccessKeyKind = reflect.TypeOf(AWSIAMAccessKey{}).Name()
+	// GCPServiceAccountKeyKind is the kind name for GCPServiceAccountKey resource.
+	GCPServiceAccountKeyKind = reflect.TypeOf(GCPServiceAccountKey{}).Name()
+	// AzureApplicationSecretKind is the kind name for AzureApplicationSecret resource.
+	AzureApplicationSecretKind = reflect.TypeOf(AzureApplicationSecret{}).Name()
+	// RegistryCredentialsKind is the kind name for RegistryCredentials resource.
+	RegistryCredentialsKind = reflect.TypeOf(RegistryCredentials{}).Name()
+	// CompositeKind is the kind name for Composite resource.
+	CompositeKind = reflect.TypeOf(Composite{}).Name()
 )
 
 func init() {
@@ -109,4 +125,12 @@ func init() {
 	SchemeBuilder.Register(&Webhook{}, &WebhookList{})
 	SchemeBuilder.Register(&Grafana{}, &GrafanaList{})
 	SchemeBuilder.Register(&MFA{}, &MFAList{})
+	SchemeBuilder.Register(&DatabaseUser{}, &DatabaseUserList{})
+	SchemeBuilder.Register(&ServiceAccountToken{}, &ServiceAccountTokenList{})
+	SchemeBuilder.Register(&JWT{}, &JWTList{})
+	SchemeBuilder.Register(&AWSIAMAccessKey{}, &AWSIAMAccessKeyList{})
+	SchemeBuilder.Register(&GCPServiceAccountKey{}, &GCPServiceAccountKeyList{})
+	SchemeBuilder.Register(&AzureApplicationSecret{}, &AzureApplicationSecretList{})
+	SchemeBuilder.Register(&RegistryCredentials{}, &RegistryCredentialsList{})
+	SchemeBuilder.Register(&Composite{}, &CompositeList{})
 }
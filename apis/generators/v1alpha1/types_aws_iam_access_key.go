@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSIAMAccessKeySpec defines the desired state to generate an access key for an AWS IAM user.
+type AWSIAMAccessKeySpec struct {
+	// Region specifies the region to operate in.
+	Region string `json:"region"`
+
+	// Auth defines how to authenticate with AWS
+	// +optional
+	Auth AWSAuth `json:"auth,omitempty"`
+
+	// You can assume a role before making calls to the
+	// desired AWS service.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// UserName is the name of the IAM user the access key is created for.
+	UserName string `json:"userName"`
+}
+
+// AWSIAMAccessKeyState is the state type produced by the AWSIAMAccessKey generator.
+// It contains the information needed to delete the access key.
+type AWSIAMAccessKeyState struct {
+	// AccessKeyID is the ID of the generated access key.
+	AccessKeyID string `json:"accessKeyID"`
+	// UserName is the name of the IAM user the access key belongs to.
+	UserName string `json:"userName"`
+}
+
+// AWSIAMAccessKey uses the CreateAccessKey API to create a long-lived access key for an IAM user.
+// The access key is deleted once the generated secret is garbage collected.
+// Note that IAM users can have at most two access keys at a time.
+// For more information, see CreateAccessKey (https://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateAccessKey.html).
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type AWSIAMAccessKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AWSIAMAccessKeySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSIAMAccessKeyList contains a list of AWSIAMAccessKey resources.
+type AWSIAMAccessKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSIAMAccessKey `json:"items"`
+}
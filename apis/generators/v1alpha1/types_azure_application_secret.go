@@ -0,0 +1,104 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// AzureApplicationSecretSpec defines the desired state to generate a client secret for an Azure AD application.
+// The authentication options are the same as for the Azure Key Vault provider.
+type AzureApplicationSecretSpec struct {
+	// ApplicationID is the application (client) id of the application the secret is created for.
+	ApplicationID string `json:"applicationId"`
+
+	// DisplayName of the generated client secret.
+	// +kubebuilder:default=external-secrets
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Lifetime of the generated client secret.
+	// Defaults to the Microsoft Graph default of two years.
+	// +optional
+	Lifetime *metav1.Duration `json:"lifetime,omitempty"`
+
+	// Auth type defines how to authenticate to Microsoft Graph.
+	// Valid values are:
+	// - "ServicePrincipal" (default): Using a service principal (tenantId, clientId, clientSecret)
+	// - "ManagedIdentity": Using Managed Identity assigned to the pod (see aad-pod-identity)
+	// - "WorkloadIdentity": Using Workload Identity service accounts to authenticate.
+	// +optional
+	// +kubebuilder:default=ServicePrincipal
+	AuthType *esv1.AzureAuthType `json:"authType,omitempty"`
+
+	// TenantID configures the Azure Tenant to send requests to. Required for ServicePrincipal auth type.
+	// +optional
+	TenantID *string `json:"tenantId,omitempty"`
+
+	// EnvironmentType specifies the Azure cloud environment endpoints to use for
+	// connecting and authenticating with Azure. By default, it points to the public cloud AAD endpoint.
+	// PublicCloud, USGovernmentCloud and ChinaCloud are supported.
+	// +kubebuilder:default=PublicCloud
+	// +optional
+	EnvironmentType esv1.AzureEnvironmentType `json:"environmentType,omitempty"`
+
+	// Auth configures how the operator authenticates with Azure. Required for ServicePrincipal auth type.
+	// +optional
+	AuthSecretRef *esv1.AzureKVAuth `json:"authSecretRef,omitempty"`
+
+	// ServiceAccountRef specified the service account
+	// that should be used when authenticating with WorkloadIdentity.
+	// +optional
+	ServiceAccountRef *esmeta.ServiceAccountSelector `json:"serviceAccountRef,omitempty"`
+
+	// If multiple Managed Identity is assigned to the pod, you can select the one to be used
+	// +optional
+	IdentityID *string `json:"identityId,omitempty"`
+}
+
+// AzureApplicationSecretState is the state type produced by the AzureApplicationSecret generator.
+// It contains the information needed to remove the client secret.
+type AzureApplicationSecretState struct {
+	// KeyID is the id of the generated client secret.
+	KeyID string `json:"keyId"`
+}
+
+// AzureApplicationSecret creates a client secret for an Azure AD application using Microsoft Graph.
+// The client secret is removed once the generated secret is garbage collected.
+// The identity needs the Application.ReadWrite.OwnedBy or Application.ReadWrite.All permission.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type AzureApplicationSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AzureApplicationSecretSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureApplicationSecretList contains a list of AzureApplicationSecret resources.
+type AzureApplicationSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureApplicationSecret `json:"items"`
+}
@@ -30,7 +30,7 @@ type ClusterGeneratorSpec struct {
 }
 
 // GeneratorKind represents a kind of generator.
-// +kubebuilder:validation:Enum=ACRAccessToken;CloudsmithAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana
+// +kubebuilder:validation:Enum=ACRAccessToken;CloudsmithAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;DatabaseUser;ServiceAccountToken;JWT;AWSIAMAccessKey;GCPServiceAccountKey;AzureApplicationSecret;RegistryCredentials;Composite
 type GeneratorKind string
 
 const (
@@ -64,27 +64,51 @@ const (
 	GeneratorKindMFA GeneratorKind = "MFA"
 	// GeneratorKindCloudsmithAccessToken represents a Cloudsmith access token generator.
 	GeneratorKindCloudsmithAccessToken GeneratorKind = "CloudsmithAccessToken"
+	// GeneratorKindDatabaseUser represents a database user generator.
+	GeneratorKindDatabaseUser GeneratorKind = "DatabaseUser"
+	// GeneratorKindServiceAccountToken represents a service account token generator.
+	GeneratorKindServiceAccountToken GeneratorKind = "ServiceAccountToken"
+	// GeneratorKindJWT represents a JSON Web Token generator.
+	GeneratorKindJWT GeneratorKind = "JWT"
+	// GeneratorKindAWSIAMAccessKey represents an AWS IAM access key generator.
+	GeneratorKindAWSIAMAccessKey GeneratorKind = "AWSIAMAccessKey"
+	// GeneratorKindGCPServiceAccountKey represents a GCP service account key generator.
+	GeneratorKindGCPServiceAccountKey GeneratorKind = "GCPServiceAccountKey"
+	// GeneratorKindAzureApplicationSecret represents an Azure application secret generator.
+	GeneratorKindAzureApplicationSecret GeneratorKind = "AzureApplicationSecret"
+	// GeneratorKindRegistryCredentials represents a generator that merges registry credentials.
+	GeneratorKindRegistryCredentials GeneratorKind = "RegistryCredentials"
+	// GeneratorKindComposite represents a generator that combines other generators.
+	GeneratorKindComposite GeneratorKind = "Composite"
 )
 
 // GeneratorSpec defines the configuration for various supported generator types.
 // +kubebuilder:validation:MaxProperties=1
 // +kubebuilder:validation:MinProperties=1
 type GeneratorSpec struct {
-	ACRAccessTokenSpec        *ACRAccessTokenSpec        `json:"acrAccessTokenSpec,omitempty"`
-	CloudsmithAccessTokenSpec *CloudsmithAccessTokenSpec `json:"cloudsmithAccessTokenSpec,omitempty"`
-	ECRAuthorizationTokenSpec *ECRAuthorizationTokenSpec `json:"ecrAuthorizationTokenSpec,omitempty"`
-	FakeSpec                  *FakeSpec                  `json:"fakeSpec,omitempty"`
-	GCRAccessTokenSpec        *GCRAccessTokenSpec        `json:"gcrAccessTokenSpec,omitempty"`
-	GithubAccessTokenSpec     *GithubAccessTokenSpec     `json:"githubAccessTokenSpec,omitempty"`
-	QuayAccessTokenSpec       *QuayAccessTokenSpec       `json:"quayAccessTokenSpec,omitempty"`
-	PasswordSpec              *PasswordSpec              `json:"passwordSpec,omitempty"`
-	SSHKeySpec                *SSHKeySpec                `json:"sshKeySpec,omitempty"`
-	STSSessionTokenSpec       *STSSessionTokenSpec       `json:"stsSessionTokenSpec,omitempty"`
-	UUIDSpec                  *UUIDSpec                  `json:"uuidSpec,omitempty"`
-	VaultDynamicSecretSpec    *VaultDynamicSecretSpec    `json:"vaultDynamicSecretSpec,omitempty"`
-	WebhookSpec               *WebhookSpec               `json:"webhookSpec,omitempty"`
-	GrafanaSpec               *GrafanaSpec               `json:"grafanaSpec,omitempty"`
-	MFASpec                   *MFASpec                   `json:"mfaSpec,omitempty"`
+	ACRAccessTokenSpec         *ACRAccessTokenSpec         `json:"acrAccessTokenSpec,omitempty"`
+	CloudsmithAccessTokenSpec  *CloudsmithAccessTokenSpec  `json:"cloudsmithAccessTokenSpec,omitempty"`
+	ECRAuthorizationTokenSpec  *ECRAuthorizationTokenSpec  `json:"ecrAuthorizationTokenSpec,omitempty"`
+	FakeSpec                   *FakeSpec                   `json:"fakeSpec,omitempty"`
+	GCRAccessTokenSpec         *GCRAccessTokenSpec         `json:"gcrAccessTokenSpec,omitempty"`
+	GithubAccessTokenSpec      *GithubAccessTokenSpec      `json:"githubAccessTokenSpec,omitempty"`
+	QuayAccessTokenSpec        *QuayAccessTokenSpec        `json:"quayAccessTokenSpec,omitempty"`
+	PasswordSpec               *PasswordSpec               `json:"passwordSpec,omitempty"`
+	SSHKeySpec                 *SSHKeySpec                 `json:"sshKeySpec,omitempty"`
+	STSSessionTokenSpec        *STSSessionTokenSpec        `json:"stsSessionTokenSpec,omitempty"`
+	UUIDSpec                   *UUIDSpec                   `json:"uuidSpec,omitempty"`
+	VaultDynamicSecretSpec     *VaultDynamicSecretSpec     `json:"vaultDynamicSecretSpec,omitempty"`
+	WebhookSpec                *WebhookSpec                `json:"webhookSpec,omitempty"`
+	GrafanaSpec                *GrafanaSpec                `json:"grafanaSpec,omitempty"`
+	MFASpec                    *MFASpec                    `json:"mfaSpec,omitempty"`
+	DatabaseUserSpec           *DatabaseUserSpec           `json:"databaseUserSpec,omitempty"`
+	ServiceAccountTokenSpec    *ServiceAccountTokenSpec    `json:"serviceAccountTokenSpec,omitempty"`
+	JWTSpec                    *JWTSpec                    `json:"jwtSpec,omitempty"`
+	AWSIAMAccessKeySpec        *AWSIAMAccessKeySpec        `json:"awsIamAccessKeySpec,omitempty"`
+	GCPServiceAccountKeySpec   *GCPServiceAccountKeySpec   `json:"gcpServiceAccountKeySpec,omitempty"`
+	AzureApplicationSecretSpec *AzureApplicationSecretSpec `json:"azureApplicationSecretSpec,omitempty"`
+	RegistryCredentialsSpec    *RegistryCredentialsSpec    `json:"registryCredentialsSpec,omitempty"`
+	CompositeSpec              *CompositeSpec              `json:"compositeSpec,omitempty"`
 }
 
 // ClusterGenerator represents a cluster-wide generator which can be referenced as part of `generatorRef` fields.
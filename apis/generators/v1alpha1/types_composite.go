@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+// CompositeSpec defines the generators to run and how their outputs are combined.
+type CompositeSpec struct {
+	// Generators are run in the given order.
+	// If one of them fails, the values created by the previous ones are cleaned up again.
+	// +kubebuilder:validation:MinItems=1
+	Generators []CompositeGenerator `json:"generators"`
+
+	// Template maps the output keys to templates that render their values.
+	// The outputs of the generators are available as `.<name>.<key>`,
+	// e.g. `.password.password` for the `password` key of the generator named `password`.
+	// +kubebuilder:validation:MinProperties=1
+	Template map[string]string `json:"template"`
+}
+
+// CompositeGenerator references a generator that is run as part of a Composite generator.
+type CompositeGenerator struct {
+	// Name is used to reference the outputs of the generator in the template.
+	// +kubebuilder:validation:Pattern:=^[a-zA-Z_][a-zA-Z0-9_]*$
+	Name string `json:"name"`
+
+	// GeneratorRef points to the generator resource.
+	GeneratorRef esv1.GeneratorRef `json:"generatorRef"`
+}
+
+// CompositeState is the state type produced by the Composite generator.
+// It contains the state of the referenced generators, so they can be cleaned up.
+type CompositeState struct {
+	// Generators contains the state of the referenced generators that returned one.
+	Generators []CompositeGeneratorState `json:"generators,omitempty"`
+}
+
+// CompositeGeneratorState is the state of a single referenced generator.
+type CompositeGeneratorState struct {
+	// Name of the generator in the Composite spec.
+	Name string `json:"name"`
+	// Kind of the referenced generator.
+	Kind string `json:"kind"`
+	// Resource is the generator manifest that was used to generate the values.
+	Resource *apiextensions.JSON `json:"resource"`
+	// State is the state returned by the referenced generator.
+	State *apiextensions.JSON `json:"state"`
+}
+
+// Composite runs multiple generators as a single unit and templates their outputs into the final keys.
+// This allows deriving multiple values from the same generated value, e.g. a password,
+// its bcrypt hash and a connection URL.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type Composite struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CompositeSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompositeList contains a list of Composite resources.
+type CompositeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Composite `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseUserDriver is the database engine the DatabaseUser generator connects to.
+// +kubebuilder:validation:Enum=postgres;mysql
+type DatabaseUserDriver string
+
+const (
+	// DatabaseUserDriverPostgres connects to a PostgreSQL server.
+	DatabaseUserDriverPostgres DatabaseUserDriver = "postgres"
+	// DatabaseUserDriverMySQL connects to a MySQL or MariaDB server.
+	DatabaseUserDriverMySQL DatabaseUserDriver = "mysql"
+)
+
+// DatabaseUserSpec controls the behavior of the database user generator.
+type DatabaseUserSpec struct {
+	// Driver is the database engine of the server.
+	Driver DatabaseUserDriver `json:"driver"`
+
+	// Host is the hostname of the database server.
+	Host string `json:"host"`
+
+	// Port of the database server.
+	// Defaults to 5432 for postgres and 3306 for mysql.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Database to connect to. It is also used in the generated DSN.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// SSLMode is passed as `sslmode` for postgres and as `tls` for mysql.
+	// +optional
+	SSLMode string `json:"sslMode,omitempty"`
+
+	// Auth contains the admin credentials used to create and drop users.
+	Auth DatabaseUserAuth `json:"auth"`
+
+	// User is the configuration of the user that is created by the generator.
+	User DatabaseUserConfig `json:"user"`
+}
+
+// DatabaseUserAuth defines the admin credentials of the database server.
+type DatabaseUserAuth struct {
+	// Username of the admin user.
+	Username SecretKeySelector `json:"username"`
+	// Password of the admin user.
+	Password SecretKeySelector `json:"password"`
+}
+
+// DatabaseUserConfig defines the user that is created by the generator.
+type DatabaseUserConfig struct {
+	// Prefix of the generated username, a random suffix is appended to make it unique.
+	// +kubebuilder:default=eso
+	// +kubebuilder:validation:Pattern:=^[a-z_][a-z0-9_]*$
+	// +kubebuilder:validation:MaxLength:=20
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// PasswordLength is the length of the generated password.
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Minimum:=16
+	// +kubebuilder:validation:Maximum:=64
+	// +optional
+	PasswordLength int `json:"passwordLength,omitempty"`
+
+	// Roles which are granted to the user.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Statements are SQL statements which are executed after the user has been created,
+	// e.g. to grant privileges. If one of the statements fails, the user is dropped again.
+	// The templates `{{ .username }}` and `{{ .database }}` contain the quoted username and database.
+	// +optional
+	Statements []string `json:"statements,omitempty"`
+
+	// Host is the host part of the MySQL account, defaults to `%`.
+	// It is ignored for postgres.
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// DatabaseUserState is the state type produced by the DatabaseUser generator.
+// It contains the information needed to drop the user.
+type DatabaseUserState struct {
+	// Username of the generated user.
+	Username string `json:"username"`
+	// Host is the host part of the MySQL account.
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// DatabaseUser generates a user with a random password in a PostgreSQL or MySQL database.
+// The user is dropped once the generated secret is garbage collected.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type DatabaseUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DatabaseUserSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseUserList contains a list of DatabaseUser resources.
+type DatabaseUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatabaseUser `json:"items"`
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPServiceAccountKeySpec defines the desired state to generate a key for a GCP service account.
+type GCPServiceAccountKeySpec struct {
+	// Auth defines the means for authenticating with GCP
+	Auth GCPSMAuth `json:"auth"`
+	// ProjectID defines which project to use to authenticate with
+	ProjectID string `json:"projectID"`
+
+	// ServiceAccountEmail is the email of the service account the key is created for.
+	ServiceAccountEmail string `json:"serviceAccountEmail"`
+
+	// KeyAlgorithm is the algorithm of the generated key.
+	// +kubebuilder:validation:Enum=KEY_ALG_RSA_1024;KEY_ALG_RSA_2048
+	// +kubebuilder:default=KEY_ALG_RSA_2048
+	// +optional
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+}
+
+// GCPServiceAccountKeyState is the state type produced by the GCPServiceAccountKey generator.
+// It contains the information needed to delete the key.
+type GCPServiceAccountKeyState struct {
+	// Name is the resource name of the generated key,
+	// e.g. projects/{project}/serviceAccounts/{email}/keys/{id}.
+	Name string `json:"name"`
+}
+
+// GCPServiceAccountKey creates a long-lived key for a GCP service account.
+// The key is deleted once the generated secret is garbage collected.
+// Note that service accounts can have at most ten keys at a time.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type GCPServiceAccountKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GCPServiceAccountKeySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPServiceAccountKeyList contains a list of GCPServiceAccountKey resources.
+type GCPServiceAccountKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPServiceAccountKey `json:"items"`
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JWTAlgorithm is the algorithm used to sign the token.
+// +kubebuilder:validation:Enum=HS256;HS384;HS512;RS256;RS384;RS512;PS256;PS384;PS512;ES256;ES384;ES512;EdDSA
+type JWTAlgorithm string
+
+// JWTSpec controls the behavior of the JWT generator.
+type JWTSpec struct {
+	// Algorithm is the signature algorithm of the token.
+	Algorithm JWTAlgorithm `json:"algorithm"`
+
+	// KeyRef references the signing key. RSA, ECDSA and Ed25519 keys can be provided
+	// as JWK or PEM, HMAC keys as JWK or as the raw secret.
+	KeyRef SecretKeySelector `json:"keyRef"`
+
+	// KeyID is set as `kid` header of the token.
+	// Defaults to the `kid` of the key if it is provided as JWK.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Claims is a template which renders the claims of the token as a JSON or YAML object.
+	// The templates `{{ .iat }}`, `{{ .exp }}` and `{{ .jti }}` contain the computed values of
+	// the respective claims, which are always set and can not be overwritten.
+	// +optional
+	Claims string `json:"claims,omitempty"`
+
+	// Lifetime of the token, used to compute the `exp` claim.
+	// +kubebuilder:default="1h"
+	// +optional
+	Lifetime *metav1.Duration `json:"lifetime,omitempty"`
+}
+
+// JWT generates a signed JSON Web Token.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type JWT struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec JWTSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTList contains a list of JWT resources.
+type JWTList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWT `json:"items"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// RegistryCredentialsSpec defines the registries that are merged into a single docker config.
+type RegistryCredentialsSpec struct {
+	// Registries is the list of registries to add to the `auths` section of the docker config.
+	// +kubebuilder:validation:MinItems=1
+	Registries []RegistryCredentialsSource `json:"registries"`
+}
+
+// RegistryCredentialsSource defines where the credentials of a single registry come from.
+// Exactly one of GeneratorRef or Auth must be set.
+type RegistryCredentialsSource struct {
+	// Registry is the key of the `auths` entry, e.g. `ghcr.io`.
+	// It is required unless the referenced generator outputs the registry itself,
+	// like the `proxy_endpoint` of ECRAuthorizationToken or the `registry` of QuayAccessToken.
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// GeneratorRef points to a generator that produces the credentials for the registry.
+	// The generator must output either `username` and `password` or a base64 encoded `auth`.
+	// +optional
+	GeneratorRef *esv1.GeneratorRef `json:"generatorRef,omitempty"`
+
+	// Auth references static credentials for the registry.
+	// +optional
+	Auth *RegistryCredentialsAuth `json:"auth,omitempty"`
+}
+
+// RegistryCredentialsAuth references static registry credentials stored in a Kind=Secret.
+type RegistryCredentialsAuth struct {
+	// Username is the secret key selector for the registry username.
+	Username esmeta.SecretKeySelector `json:"username"`
+	// Password is the secret key selector for the registry password.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
+
+// RegistryCredentialsState is the state type produced by the RegistryCredentials generator.
+// It contains the state of the referenced generators, so they can be cleaned up.
+type RegistryCredentialsState struct {
+	// Sources contains the state of the referenced generators that returned one.
+	Sources []RegistryCredentialsSourceState `json:"sources,omitempty"`
+}
+
+// RegistryCredentialsSourceState is the state of a single referenced generator.
+type RegistryCredentialsSourceState struct {
+	// Kind of the referenced generator.
+	Kind string `json:"kind"`
+	// Resource is the generator manifest that was used to generate the credentials.
+	Resource *apiextensions.JSON `json:"resource"`
+	// State is the state returned by the referenced generator.
+	State *apiextensions.JSON `json:"state"`
+}
+
+// RegistryCredentials merges the credentials of multiple container registries
+// into a single `.dockerconfigjson`.
+// The credentials are either generated by other generators (e.g. ECRAuthorizationToken,
+// GCRAccessToken, ACRAccessToken) or read from a Kind=Secret.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type RegistryCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RegistryCredentialsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistryCredentialsList contains a list of RegistryCredentials resources.
+type RegistryCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryCredentials `json:"items"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// ServiceAccountTokenSpec controls the behavior of the service account token generator.
+type ServiceAccountTokenSpec struct {
+	// ServiceAccountRef references the service account a token is requested for.
+	// The namespace is only used when a remote cluster is configured,
+	// otherwise the namespace of the generator is used.
+	ServiceAccountRef esmeta.ServiceAccountSelector `json:"serviceAccountRef"`
+
+	// ExpirationSeconds is the requested duration of validity of the token.
+	// The API server may return a token with a shorter validity.
+	// Defaults to the API server default of one hour.
+	// +kubebuilder:validation:Minimum:=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+
+	// Cluster configures a remote cluster the token is requested from.
+	// If not set, the token is requested from the cluster the controller runs in.
+	// +optional
+	Cluster *ServiceAccountTokenCluster `json:"cluster,omitempty"`
+
+	// Kubeconfig enables rendering a kubeconfig that uses the token.
+	// It is returned with the `kubeconfig` key.
+	// +optional
+	Kubeconfig *ServiceAccountTokenKubeconfig `json:"kubeconfig,omitempty"`
+}
+
+// ServiceAccountTokenCluster configures access to a remote cluster.
+// It uses the same options as the Kubernetes provider.
+type ServiceAccountTokenCluster struct {
+	// configures the Kubernetes server Address.
+	// +optional
+	Server esv1.KubernetesServer `json:"server,omitempty"`
+
+	// Auth configures how the generator authenticates with the remote cluster.
+	// +optional
+	Auth *esv1.KubernetesAuth `json:"auth,omitempty"`
+
+	// A reference to a secret that contains the auth information.
+	// +optional
+	AuthRef *esmeta.SecretKeySelector `json:"authRef,omitempty"`
+}
+
+// ServiceAccountTokenKubeconfig configures the rendered kubeconfig.
+type ServiceAccountTokenKubeconfig struct {
+	// Server is the API server URL written to the kubeconfig.
+	// Defaults to the URL used to request the token, which is not
+	// reachable from outside the cluster for in-cluster requests.
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// ClusterName is the name of the cluster and context in the kubeconfig.
+	// +kubebuilder:default=default
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// ServiceAccountToken generates a token for a service account using the TokenRequest API.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type ServiceAccountToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceAccountTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountTokenList contains a list of ServiceAccountToken resources.
+type ServiceAccountTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountToken `json:"items"`
+}
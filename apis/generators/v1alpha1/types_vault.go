@@ -59,6 +59,41 @@ type VaultDynamicSecretSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	AllowEmptyResponse bool `json:"allowEmptyResponse,omitempty"`
+
+	// RenewLease configures the generator to renew the lease of the previously
+	// generated secret instead of generating a new one, as long as the lease is renewable.
+	// This requires the generator state feature to be enabled, the generated values are
+	// stored in a Secret next to the GeneratorState so they can be re-used.
+	// +optional
+	RenewLease *VaultLeaseRenewal `json:"renewLease,omitempty"`
+}
+
+// VaultLeaseRenewal configures the renewal of the lease of a dynamic secret.
+type VaultLeaseRenewal struct {
+	// Increment is the requested extension of the lease.
+	// If not set, Vault extends the lease by the default TTL of the secrets engine.
+	// +optional
+	Increment *metav1.Duration `json:"increment,omitempty"`
+
+	// MinTTL is the minimum TTL the lease must have after it has been renewed.
+	// If the lease can not be extended beyond it, e.g. because it reached its max TTL,
+	// a new secret is generated instead. It should be at least the refresh interval
+	// of the resource that references the generator.
+	// +optional
+	MinTTL *metav1.Duration `json:"minTTL,omitempty"`
+}
+
+// VaultDynamicSecretState is the state of a secret generated by the VaultDynamicSecret generator.
+// It is used to renew and revoke the lease of the secret.
+type VaultDynamicSecretState struct {
+	// LeaseID is the ID of the lease of the generated secret.
+	LeaseID string `json:"leaseID,omitempty"`
+	// Accessor is the accessor of the generated token when the response contains an auth section.
+	Accessor string `json:"accessor,omitempty"`
+	// LeaseDuration is the TTL of the lease in seconds.
+	LeaseDuration int `json:"leaseDuration,omitempty"`
+	// Renewable indicates whether the lease can be renewed.
+	Renewable bool `json:"renewable,omitempty"`
 }
 
 // VaultDynamicSecretResultType defines which part of the Vault API response should be returned.
@@ -65,6 +65,44 @@ type WebhookSpec struct {
 	// The provider for the CA bundle to use to validate webhook server certificate.
 	// +optional
 	CAProvider *WebhookCAProvider `json:"caProvider,omitempty"`
+
+	// Cleanup is an optional request which is sent when a generated secret is garbage collected,
+	// e.g. to revoke the credentials that were issued by the webhook.
+	// It uses the same auth, secrets, timeout and CA configuration as the generate request.
+	// +optional
+	Cleanup *WebhookCleanup `json:"cleanup,omitempty"`
+}
+
+// WebhookCleanup defines the request that is sent to clean up a generated secret.
+type WebhookCleanup struct {
+	// Webhook Method
+	// +optional, default DELETE
+	Method string `json:"method,omitempty"`
+
+	// Webhook url to call
+	URL string `json:"url"`
+
+	// Headers, defaults to the headers of the generate request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// Values to capture from the response of the generate request.
+	// The key is the name of the value and the value is a JSONPath expression
+	// which is evaluated against the whole response body.
+	// Captured values are stored in the GeneratorState and are available
+	// in the url, headers and body templates as `{{ .state.<name> }}`.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// WebhookState is the state of a secret generated by the Webhook generator.
+type WebhookState struct {
+	// Values captured from the response of the generate request.
+	Values map[string]string `json:"values,omitempty"`
 }
 
 // AuthorizationProtocol contains the protocol-specific configuration
@@ -18,15 +18,19 @@ package controller
 
 import (
 	"crypto/tls"
+	"errors"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	v1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,9 +53,11 @@ import (
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret"
 	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret/psmetrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/receiver"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore/cssmetrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore/ssmetrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/sharding"
 	"github.com/external-secrets/external-secrets/pkg/feature"
 
 	// To allow using gcp auth.
@@ -89,8 +95,18 @@ var (
 	enablePushSecretReconciler            bool
 	enableFloodGate                       bool
 	enableGeneratorState                  bool
+	enableGeneratorWatches                bool
+	enableConfigMapWatches                bool
+	refreshReceiverAddr                   string
+	refreshReceiverSecret                 string
 	enableExtendedMetricLabels            bool
 	storeRequeueInterval                  time.Duration
+	refreshJitter                         int
+	enableSharding                        bool
+	shardingKey                           string
+	shardingID                            string
+	shardingLeaseNamespace                string
+	shardingLeaseDuration                 time.Duration
 	serviceName, serviceNamespace         string
 	secretName, secretNamespace           string
 	crdNames                              []string
@@ -124,6 +140,29 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, _ []string) {
 		setupLogger()
 
+		if refreshJitter < 0 || refreshJitter > 100 {
+			setupLog.Error(errors.New("--refresh-jitter must be between 0 and 100"), "invalid flags")
+			os.Exit(1)
+		}
+		if enableSharding {
+			if shardingKey != string(sharding.KeyNamespace) && shardingKey != string(sharding.KeyUID) {
+				setupLog.Error(errors.New("--sharding-key must be namespace or uid"), "invalid flags")
+				os.Exit(1)
+			}
+			if shardingLeaseNamespace == "" {
+				setupLog.Error(errors.New("--sharding-lease-namespace must be set when sharding is enabled"), "invalid flags")
+				os.Exit(1)
+			}
+			if shardingID == "" {
+				hostname, err := os.Hostname()
+				if err != nil {
+					setupLog.Error(err, "unable to determine sharding id")
+					os.Exit(1)
+				}
+				shardingID = hostname
+			}
+		}
+
 		ctrlmetrics.SetUpLabelNames(enableExtendedMetricLabels)
 		esmetrics.SetUpMetrics()
 		config := ctrl.GetConfigOrDie()
@@ -196,6 +235,28 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		// with sharding, every replica reconciles its share of the ExternalSecrets and PushSecrets,
+		// so their controllers run on all replicas regardless of leader election.
+		var shard *sharding.Shard
+		var needLeaderElection *bool
+		if enableSharding {
+			shard = &sharding.Shard{
+				Client:        mgr.GetClient(),
+				Reader:        mgr.GetAPIReader(),
+				Log:           ctrl.Log.WithName("sharding"),
+				ID:            shardingID,
+				Namespace:     shardingLeaseNamespace,
+				Group:         controllerClass,
+				Key:           sharding.Key(shardingKey),
+				LeaseDuration: shardingLeaseDuration,
+			}
+			if err = mgr.Add(shard); err != nil {
+				setupLog.Error(err, "unable to set up sharding")
+				os.Exit(1)
+			}
+			needLeaderElection = ptr.To(false)
+		}
+
 		ssmetrics.SetUpMetrics()
 		if err = (&secretstore.StoreReconciler{
 			Client:            mgr.GetClient(),
@@ -248,12 +309,17 @@ var rootCmd = &cobra.Command{
 			RestConfig:                mgr.GetConfig(),
 			ControllerClass:           controllerClass,
 			RequeueInterval:           time.Hour,
+			RefreshJitter:             refreshJitter,
 			ClusterSecretStoreEnabled: enableClusterStoreReconciler,
 			EnableFloodGate:           enableFloodGate,
 			EnableGeneratorState:      enableGeneratorState,
+			GeneratorWatchesEnabled:   enableGeneratorWatches,
+			ConfigMapWatchesEnabled:   enableConfigMapWatches,
+			Shard:                     shard,
 		}).SetupWithManager(mgr, controller.Options{
 			MaxConcurrentReconciles: concurrent,
 			RateLimiter:             ctrlcommon.BuildRateLimiter(),
+			NeedLeaderElection:      needLeaderElection,
 		}); err != nil {
 			setupLog.Error(err, errCreateController, "controller", "ExternalSecret")
 			os.Exit(1)
@@ -267,9 +333,11 @@ var rootCmd = &cobra.Command{
 				ControllerClass: controllerClass,
 				RestConfig:      mgr.GetConfig(),
 				RequeueInterval: time.Hour,
+				Shard:           shard,
 			}).SetupWithManager(cmd.Context(), mgr, controller.Options{
 				MaxConcurrentReconciles: concurrent,
 				RateLimiter:             ctrlcommon.BuildRateLimiter(),
+				NeedLeaderElection:      needLeaderElection,
 			}); err != nil {
 				setupLog.Error(err, errCreateController, "controller", "PushSecret")
 				os.Exit(1)
@@ -309,6 +377,23 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		if refreshReceiverAddr != "" {
+			secretNamespace, secretName, ok := strings.Cut(refreshReceiverSecret, "/")
+			if !ok || secretNamespace == "" || secretName == "" {
+				setupLog.Error(errors.New("--refresh-receiver-secret must be in the format namespace/name"), "unable to create refresh receiver")
+				os.Exit(1)
+			}
+			if err = (&receiver.Receiver{
+				Client: mgr.GetClient(),
+				Log:    ctrl.Log.WithName("receiver"),
+				Addr:   refreshReceiverAddr,
+				Secret: types.NamespacedName{Namespace: secretNamespace, Name: secretName},
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create refresh receiver")
+				os.Exit(1)
+			}
+		}
+
 		if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 			setupLog.Error(err, "unable to add controller healthz check")
 			os.Exit(1)
@@ -359,8 +444,18 @@ func init() {
 	rootCmd.Flags().BoolVar(&enableConfigMapsCache, "enable-configmaps-caching", false, "Enable configmaps caching for ALL configmaps in the cluster (WARNING: can increase memory usage).")
 	rootCmd.Flags().BoolVar(&enableManagedSecretsCache, "enable-managed-secrets-caching", true, "Enable secrets caching for secrets managed by an ExternalSecret")
 	rootCmd.Flags().DurationVar(&storeRequeueInterval, "store-requeue-interval", time.Minute*5, "Default Time duration between reconciling (Cluster)SecretStores")
+	rootCmd.Flags().IntVar(&refreshJitter, "refresh-jitter", 0, "Percentage of the refresh interval of an ExternalSecret which is added as random delay to its next refresh, between 0 and 100.")
+	rootCmd.Flags().BoolVar(&enableSharding, "enable-sharding", false, "Enable sharding of ExternalSecrets and PushSecrets between all replicas of the controller, coordinated through Leases.")
+	rootCmd.Flags().StringVar(&shardingKey, "sharding-key", "namespace", "The field ExternalSecrets and PushSecrets are assigned to replicas by, one of namespace or uid.")
+	rootCmd.Flags().StringVar(&shardingID, "sharding-id", "", "The unique id of the replica within the shard group, defaults to the hostname.")
+	rootCmd.Flags().StringVar(&shardingLeaseNamespace, "sharding-lease-namespace", "", "The namespace of the Leases of the replicas, required when sharding is enabled.")
+	rootCmd.Flags().DurationVar(&shardingLeaseDuration, "sharding-lease-duration", 15*time.Second, "The time after which a replica which did not renew its Lease is removed from the shard group.")
 	rootCmd.Flags().BoolVar(&enableFloodGate, "enable-flood-gate", true, "Enable flood gate. External secret will be reconciled only if the ClusterStore or Store have an healthy or unknown state.")
 	rootCmd.Flags().BoolVar(&enableGeneratorState, "enable-generator-state", true, "Whether the Controller should manage GeneratorState")
+	rootCmd.Flags().StringVar(&refreshReceiverAddr, "refresh-receiver-addr", "", "The address the refresh receiver binds to. The receiver is disabled if empty.")
+	rootCmd.Flags().StringVar(&refreshReceiverSecret, "refresh-receiver-secret", "", "The Secret containing the credentials of the refresh receiver, in the format namespace/name.")
+	rootCmd.Flags().BoolVar(&enableGeneratorWatches, "enable-generator-watches", true, "Whether ExternalSecrets should be refreshed when a referenced generator changes. Requires permissions to list and watch all generator kinds.")
+	rootCmd.Flags().BoolVar(&enableConfigMapWatches, "enable-configmap-watches", false, "Whether ExternalSecrets should be refreshed when a templateFrom ConfigMap changes. Watches the metadata of ALL configmaps in the cluster (WARNING: can increase memory usage).")
 	rootCmd.Flags().BoolVar(&enableExtendedMetricLabels, "enable-extended-metric-labels", false, "Enable recommended kubernetes annotations as labels in metrics.")
 	rootCmd.Flags().BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics server")
@@ -87,6 +87,12 @@ func (w *Webhook) GetSecretMap(ctx context.Context, provider *Spec, ref *esv1.Ex
 	if err != nil {
 		return nil, err
 	}
+	return ParseSecretMap(provider, result)
+}
+
+// ParseSecretMap parses the response of a webhook endpoint as a map of key-value pairs.
+func ParseSecretMap(provider *Spec, result []byte) (map[string][]byte, error) {
+	var err error
 	// We always want json here, so just parse it out
 	jsondata := any(nil)
 	if err := json.Unmarshal(result, &jsondata); err != nil {
@@ -198,6 +204,16 @@ func (w *Webhook) getTemplatedSecrets(ctx context.Context, secrets []Secret, dat
 
 // GetWebhookData makes a request to the webhook endpoint and returns the raw response data.
 func (w *Webhook) GetWebhookData(ctx context.Context, provider *Spec, ref *esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	return w.sendRequest(ctx, provider, ref, nil)
+}
+
+// SendRequest makes a request to the webhook endpoint which is templated with the given
+// values in addition to the webhook secrets, and returns the raw response data.
+func (w *Webhook) SendRequest(ctx context.Context, provider *Spec, values map[string]map[string]string) ([]byte, error) {
+	return w.sendRequest(ctx, provider, nil, values)
+}
+
+func (w *Webhook) sendRequest(ctx context.Context, provider *Spec, ref *esv1.ExternalSecretDataRemoteRef, values map[string]map[string]string) ([]byte, error) {
 	if w.HTTP == nil {
 		return nil, errors.New("http client not initialized")
 	}
@@ -211,6 +227,13 @@ func (w *Webhook) GetWebhookData(ctx context.Context, provider *Spec, ref *esv1.
 	if err != nil {
 		return nil, err
 	}
+	for name, vals := range values {
+		escapedData[name] = make(map[string]string, len(vals))
+		for k, v := range vals {
+			escapedData[name][k] = url.QueryEscape(v)
+		}
+		rawData[name] = vals
+	}
 
 	// set method
 	method := provider.Method
@@ -110,6 +110,7 @@ const (
 	CallAKEYLESSSMCreateSecret          = "CreateSecret"
 	CallAKEYLESSSMUpdateSecretVal       = "UpdateSecretVal"
 	CallAKEYLESSSMDeleteItem            = "DeleteItem"
+	CallAKEYLESSSMUpdateItem            = "UpdateItem"
 
 	StatusError   = "error"
 	StatusSuccess = "success"
@@ -37,6 +37,8 @@ const (
 	ExternalSecretStatusConditionKey = "status_condition"
 	// ExternalSecretReconcileDurationKey is the metric key for the external secret reconcile duration.
 	ExternalSecretReconcileDurationKey = "reconcile_duration"
+	// ExternalSecretStaleDataKey is the metric key for the time the oldest stale key could not be fetched.
+	ExternalSecretStaleDataKey = "stale_data_seconds"
 )
 
 var counterVecMetrics = map[string]*prometheus.CounterVec{}
@@ -71,7 +73,13 @@ func SetUpMetrics() {
 		Help:      "The duration time to reconcile the External Secret",
 	}, ctrlmetrics.NonConditionMetricLabelNames)
 
-	metrics.Registry.MustRegister(syncCallsTotal, syncCallsError, externalSecretCondition, externalSecretReconcileDuration)
+	externalSecretStaleData := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: ExternalSecretSubsystem,
+		Name:      ExternalSecretStaleDataKey,
+		Help:      "The time since the oldest key of the External Secret that keeps its last known good value could not be fetched",
+	}, ctrlmetrics.NonConditionMetricLabelNames)
+
+	metrics.Registry.MustRegister(syncCallsTotal, syncCallsError, externalSecretCondition, externalSecretReconcileDuration, externalSecretStaleData)
 
 	counterVecMetrics = map[string]*prometheus.CounterVec{
 		SyncCallsKey:      syncCallsTotal,
@@ -81,6 +89,7 @@ func SetUpMetrics() {
 	gaugeVecMetrics = map[string]*prometheus.GaugeVec{
 		ExternalSecretStatusConditionKey:   externalSecretCondition,
 		ExternalSecretReconcileDurationKey: externalSecretReconcileDuration,
+		ExternalSecretStaleDataKey:         externalSecretStaleData,
 	}
 }
 
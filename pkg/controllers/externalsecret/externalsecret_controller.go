@@ -23,8 +23,10 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"math/rand/v2"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -54,6 +56,8 @@ import (
 	// Metrics.
 	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+	"github.com/external-secrets/external-secrets/pkg/controllers/sharding"
 	"github.com/external-secrets/external-secrets/pkg/controllers/util"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
 	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
@@ -74,6 +78,7 @@ const (
 	// condition messages for "SecretSynced" reason.
 	msgSynced       = "secret synced"
 	msgSyncedRetain = "secret retained due to DeletionPolicy=Retain"
+	msgPinned       = "secret pinned to revision %d"
 
 	// condition messages for "SecretDeleted" reason.
 	msgDeleted = "secret deleted due to DeletionPolicy=Delete"
@@ -89,6 +94,10 @@ const (
 	msgErrorUpdateImmutable = "could not update secret, target is immutable"
 	msgErrorBecomeOwner     = "failed to take ownership of target secret"
 	msgErrorIsOwned         = "target is owned by another ExternalSecret"
+	msgErrorRollout         = "could not roll out workloads"
+	msgErrorRollback        = "could not roll back to revision"
+	msgErrorHistory         = "could not record revision in history"
+	msgErrorLastKnownGood   = "could not save last known good values"
 
 	// log messages.
 	logErrorGetES                = "unable to get ExternalSecret"
@@ -103,6 +112,8 @@ const (
 	errRewrite               = "error applying rewrite to keys: %w"
 	errDecode                = "error applying decoding strategy %s to data: %w"
 	errGenerate              = "error using generator: %w"
+	errRenew                 = "error renewing generated values: %w"
+	errReuse                 = "error re-using generated values: %w"
 	errInvalidKeys           = "invalid secret keys (TIP: use rewrite or conversionStrategy to change keys): %w"
 	errFetchTplFrom          = "error fetching templateFrom data: %w"
 	errApplyTemplate         = "could not apply template: %w"
@@ -120,6 +131,8 @@ const (
 	eventDeletedOrphaned          = "secret deleted because it was orphaned"
 	eventMissingProviderSecret    = "secret does not exist at provider using spec.dataFrom[%d]"
 	eventMissingProviderSecretKey = "secret does not exist at provider using spec.dataFrom[%d] (key=%s)"
+	eventStaleData                = "keeping last known good value of keys that could not be fetched: %s"
+	eventSkippedData              = "skipped entries that could not be fetched: %s"
 )
 
 // these errors are explicitly defined so we can detect them with `errors.Is()`.
@@ -139,12 +152,20 @@ type Reconciler struct {
 	Log                       logr.Logger
 	Scheme                    *runtime.Scheme
 	RestConfig                *rest.Config
+	APIReader                 client.Reader
+	Shard                     *sharding.Shard
 	ControllerClass           string
 	RequeueInterval           time.Duration
+	RefreshJitter             int
 	ClusterSecretStoreEnabled bool
 	EnableFloodGate           bool
 	EnableGeneratorState      bool
+	GeneratorWatchesEnabled   bool
+	ConfigMapWatchesEnabled   bool
 	recorder                  record.EventRecorder
+
+	// dependencyChanged tracks the ExternalSecrets which are enqueued because a dependency changed.
+	dependencyChanged sync.Map
 }
 
 // Reconcile implements the main reconciliation loop
@@ -164,6 +185,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		esmetrics.GetCounterVec(esmetrics.SyncCallsKey).With(resourceLabels).Inc()
 	}()
 
+	// the dependency change signal is taken by every reconcile, so it is not left behind for ExternalSecrets
+	// which were deleted, are owned by another shard or are not refreshed. It is kept for the retry of a failed reconcile.
+	dependencyChanged := r.takeDependencyChanged(req.NamespacedName)
+	defer func() {
+		if err != nil && dependencyChanged {
+			r.dependencyChanged.Store(req.NamespacedName, struct{}{})
+		}
+	}()
+
 	externalSecret := &esv1.ExternalSecret{}
 	err = r.Get(ctx, req.NamespacedName, externalSecret)
 	if err != nil {
@@ -187,6 +217,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
+	// the ExternalSecret is reconciled by another replica of the shard group
+	if !r.Shard.Owns(externalSecret) {
+		log.V(1).Info("skipping ExternalSecret, owned by another shard")
+		return ctrl.Result{}, nil
+	}
+
 	// Handle deletion with finalizer
 	if !externalSecret.GetDeletionTimestamp().IsZero() {
 		// Always attempt cleanup to handle edge case where finalizer might be removed externally
@@ -238,6 +274,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		secretName = externalSecret.Name
 	}
 
+	// with versioning, the existing secret is the current version of the target secret
+	existingSecretName := secretName
+	if externalSecret.Spec.Target.Versioning != nil && externalSecret.Status.CurrentSecretName != "" {
+		existingSecretName = externalSecret.Status.CurrentSecretName
+	}
+
 	// fetch the existing secret (from the partial cache)
 	//  - please note that the ~partial cache~ is different from the ~full cache~
 	//    so there can be race conditions between the two caches
@@ -248,9 +290,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	//    to reliably determine if a secret exists or not
 	secretPartial := &metav1.PartialObjectMetadata{}
 	secretPartial.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("Secret"))
-	err = r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: externalSecret.Namespace}, secretPartial)
+	err = r.Get(ctx, client.ObjectKey{Name: existingSecretName, Namespace: externalSecret.Namespace}, secretPartial)
 	if err != nil && !apierrors.IsNotFound(err) {
-		log.Error(err, logErrorGetSecret, "secretName", secretName, "secretNamespace", externalSecret.Namespace)
+		log.Error(err, logErrorGetSecret, "secretName", existingSecretName, "secretNamespace", externalSecret.Namespace)
 		syncCallsError.With(resourceLabels).Inc()
 		return ctrl.Result{}, err
 	}
@@ -266,7 +308,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		secretPartial.Labels[esv1.LabelManaged] = esv1.LabelManagedValue
 		err = r.Patch(ctx, secretPartial, patch, client.FieldOwner(fqdn))
 		if err != nil {
-			log.Error(err, logErrorPatchSecret, "secretName", secretName, "secretNamespace", externalSecret.Namespace)
+			log.Error(err, logErrorPatchSecret, "secretName", existingSecretName, "secretNamespace", externalSecret.Namespace)
 			syncCallsError.With(resourceLabels).Inc()
 			return ctrl.Result{}, err
 		}
@@ -279,9 +321,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	//       otherwise it will be the normal controller-runtime client which may be cached or make direct API calls,
 	//       depending on if `enabledSecretCache` is true or false.
 	existingSecret := &v1.Secret{}
-	err = r.SecretClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: externalSecret.Namespace}, existingSecret)
+	err = r.SecretClient.Get(ctx, client.ObjectKey{Name: existingSecretName, Namespace: externalSecret.Namespace}, existingSecret)
 	if err != nil && !apierrors.IsNotFound(err) {
-		log.Error(err, logErrorGetSecret, "secretName", secretName, "secretNamespace", externalSecret.Namespace)
+		log.Error(err, logErrorGetSecret, "secretName", existingSecretName, "secretNamespace", externalSecret.Namespace)
 		syncCallsError.With(resourceLabels).Inc()
 		return ctrl.Result{}, err
 	}
@@ -291,8 +333,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	//       we return an error so we get an exponential backoff if we end up looping,
 	//       for example, during high cluster load and frequent updates to the target secret by other controllers.
 	if secretPartial.UID != existingSecret.UID || secretPartial.ResourceVersion != existingSecret.ResourceVersion {
-		err = fmt.Errorf(errSecretCachesNotSynced, secretName)
-		log.Error(err, logErrorSecretCacheNotSynced, "secretName", secretName, "secretNamespace", externalSecret.Namespace)
+		err = fmt.Errorf(errSecretCachesNotSynced, existingSecretName)
+		log.Error(err, logErrorSecretCacheNotSynced, "secretName", existingSecretName, "secretNamespace", externalSecret.Namespace)
 		syncCallsError.With(resourceLabels).Inc()
 		return ctrl.Result{}, err
 	}
@@ -301,11 +343,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	// 1. refresh interval is not 0
 	// 2. resource generation of the ExternalSecret has not changed
 	// 3. the last refresh time of the ExternalSecret is within the refresh interval
-	// 4. the target secret is valid:
+	// 4. no referenced store, templateFrom source or generator changed since the last reconcile
+	// 5. the target secret is valid:
 	//     - it exists
 	//     - it has the correct "managed" label
 	//     - it has the correct "data-hash" annotation
-	if !shouldRefresh(externalSecret) && isSecretValid(existingSecret, externalSecret) {
+	if !shouldRefresh(externalSecret) && !(dependencyChanged && refreshOnDependencyChange(externalSecret)) && isSecretValid(existingSecret, externalSecret) {
 		log.V(1).Info("skipping refresh")
 		return r.getRequeueResult(externalSecret), nil
 	}
@@ -349,13 +392,48 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}()
 
-	// retrieve the provider secret data.
-	dataMap, err := r.GetProviderSecretData(ctx, externalSecret)
+	// list the generator states, so it is visible which generated values are still live.
+	if err := r.setGeneratorStates(ctx, externalSecret); err != nil {
+		log.Error(err, "unable to list generator states")
+	}
+
+	// retrieve the provider secret data, unless the rollback annotation pins the data to a revision of the history.
+	dataMap, pinned, err := r.getRevisionData(ctx, externalSecret)
+	if err != nil {
+		r.markAsFailed(msgErrorRollback, err, externalSecret, syncCallsError.With(resourceLabels))
+		return ctrl.Result{}, err
+	}
+	var sourceVersions map[string]string
+	var lkg *lastKnownGood
+	if !pinned {
+		dataMap, sourceVersions, lkg, err = r.GetProviderSecretData(ctx, externalSecret)
+	}
+	var storeUnavailable *secretstore.StoreUnavailableError
+	if errors.As(err, &storeUnavailable) {
+		// do not retry before the circuit breaker of the store allows requests again.
+		r.markAsStoreUnavailable(storeUnavailable, externalSecret, syncCallsError.With(resourceLabels))
+		return ctrl.Result{RequeueAfter: storeUnavailable.RetryAfter}, nil
+	}
 	if err != nil {
 		r.markAsFailed(msgErrorGetSecretData, err, externalSecret, syncCallsError.With(resourceLabels))
 		return ctrl.Result{}, err
 	}
 
+	// report the keys that keep their last known good values because they could not be fetched.
+	staleKeys := lkg.staleKeys()
+	setStaleKeys(externalSecret, staleKeys)
+	esmetrics.GetGaugeVec(esmetrics.ExternalSecretStaleDataKey).With(resourceLabels).Set(staleness(externalSecret).Seconds())
+	if len(staleKeys) > 0 {
+		r.recorder.Eventf(externalSecret, v1.EventTypeWarning, esv1.ReasonStaleData, eventStaleData, strings.Join(slices.Sorted(maps.Keys(staleKeys)), ", "))
+	}
+	if len(externalSecret.Status.SkippedData) > 0 {
+		paths := make([]string, 0, len(externalSecret.Status.SkippedData))
+		for _, skipped := range externalSecret.Status.SkippedData {
+			paths = append(paths, skipped.Path)
+		}
+		r.recorder.Eventf(externalSecret, v1.EventTypeWarning, esv1.ReasonSkippedData, eventSkippedData, strings.Join(paths, ", "))
+	}
+
 	// if no data was found we can delete the secret if needed.
 	if len(dataMap) == 0 {
 		switch externalSecret.Spec.Target.DeletionPolicy {
@@ -381,6 +459,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 				r.recorder.Event(externalSecret, v1.EventTypeNormal, esv1.ReasonDeleted, eventDeleted)
 			}
 
+			// delete the previous versions and the alias, they hold the data as well
+			if externalSecret.Spec.Target.Versioning != nil {
+				err = r.deleteAllVersions(ctx, externalSecret)
+				if err == nil && externalSecret.Spec.Target.Versioning.Alias {
+					err = r.deleteAlias(ctx, externalSecret, secretName)
+				}
+				if err != nil {
+					r.markAsFailed(msgErrorDeleteSecret, err, externalSecret, syncCallsError.With(resourceLabels))
+					return ctrl.Result{}, err
+				}
+			}
+
 			r.markAsDone(externalSecret, start, log, esv1.ConditionReasonSecretDeleted, msgDeleted)
 			return r.getRequeueResult(externalSecret), nil
 		// In case provider secrets don't exist the kubernetes secret will be kept as-is.
@@ -392,6 +482,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}
 
+	// dataHash is the hash of the data of the secret after it was mutated.
+	var dataHash string
+
 	// mutationFunc is a function which can be applied to a secret to make it match the desired state.
 	mutationFunc := func(secret *v1.Secret) error {
 		// get information about the current owner of the secret
@@ -482,10 +575,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 
 		secret.Labels[esv1.LabelManaged] = esv1.LabelManagedValue
 		secret.Annotations[esv1.AnnotationDataHash] = esutils.ObjectHash(secret.Data)
+		dataHash = secret.Annotations[esv1.AnnotationDataHash]
 
 		return nil
 	}
 
+	// the current secret name is only published for versioned secrets
+	if externalSecret.Spec.Target.Versioning == nil {
+		externalSecret.Status.CurrentSecretName = ""
+	}
+
 	switch externalSecret.Spec.Target.CreationPolicy {
 	case esv1.CreatePolicyNone:
 		log.V(1).Info("secret creation skipped due to CreationPolicy=None")
@@ -511,16 +610,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	case esv1.CreatePolicyOwner:
 		// we may have orphaned secrets to clean up,
 		// for example, if the target secret name was changed
-		err = r.deleteOrphanedSecrets(ctx, externalSecret, secretName)
+		err = r.deleteOrphanedSecrets(ctx, externalSecret, ownedSecretName(externalSecret, secretName))
 		if err != nil {
 			r.markAsFailed(msgErrorDeleteOrphaned, err, externalSecret, syncCallsError.With(resourceLabels))
 			return ctrl.Result{}, err
 		}
 
-		// create the secret, if it does not exist
-		if existingSecret.UID == "" {
+		switch {
+		case externalSecret.Spec.Target.Versioning != nil:
+			// create a new version of the secret, if the data changed
+			err = r.syncVersionedSecret(ctx, mutationFunc, externalSecret, secretName)
+		case existingSecret.UID == "":
+			// create the secret, if it does not exist
 			err = r.createSecret(ctx, mutationFunc, externalSecret, secretName)
-		} else {
+		default:
 			// if the secret exists, we should update it
 			err = r.updateSecret(ctx, existingSecret, mutationFunc, externalSecret, secretName)
 		}
@@ -557,6 +660,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
+	// keep the provider values, so they can be restored when the entries can not be fetched.
+	err = r.saveLastKnownGood(ctx, externalSecret, lkg)
+	if err != nil {
+		r.markAsFailed(msgErrorLastKnownGood, err, externalSecret, syncCallsError.With(resourceLabels))
+		return ctrl.Result{}, err
+	}
+
+	// keep the synced data in the history, so the secret can be rolled back to it.
+	if !pinned {
+		err = r.recordRevision(ctx, externalSecret, secretName, dataMap, sourceVersions)
+		if err != nil {
+			r.markAsFailed(msgErrorHistory, err, externalSecret, syncCallsError.With(resourceLabels))
+			return ctrl.Result{}, err
+		}
+	}
+
+	// roll out the workloads consuming the secret if its data changed.
+	err = r.rolloutWorkloads(ctx, externalSecret, secretName, dataHash)
+	if err != nil {
+		r.markAsFailed(rolloutFailedMessage(err), err, externalSecret, syncCallsError.With(resourceLabels))
+		return ctrl.Result{}, err
+	}
+
+	if pinned {
+		r.markAsDone(externalSecret, start, log, esv1.ConditionReasonSecretSynced, fmt.Sprintf(msgPinned, *externalSecret.Status.PinnedRevision))
+		return r.getRequeueResult(externalSecret), nil
+	}
+
 	r.markAsDone(externalSecret, start, log, esv1.ConditionReasonSecretSynced, msgSynced)
 	return r.getRequeueResult(externalSecret), nil
 }
@@ -579,7 +710,7 @@ func (r *Reconciler) getRequeueResult(externalSecret *esv1.ExternalSecret) ctrl.
 	// note, this should not happen, as we only call this function on ExternalSecrets
 	// that have been reconciled at least once
 	if externalSecret.Status.RefreshTime.IsZero() {
-		return ctrl.Result{RequeueAfter: refreshInterval}
+		return ctrl.Result{RequeueAfter: refreshInterval + r.jitter(refreshInterval)}
 	}
 
 	timeSinceLastRefresh := time.Since(externalSecret.Status.RefreshTime.Time)
@@ -593,13 +724,23 @@ func (r *Reconciler) getRequeueResult(externalSecret *esv1.ExternalSecret) ctrl.
 
 	// if there is time remaining, requeue after the remaining time
 	if timeSinceLastRefresh < refreshInterval {
-		return ctrl.Result{RequeueAfter: refreshInterval - timeSinceLastRefresh}
+		return ctrl.Result{RequeueAfter: refreshInterval - timeSinceLastRefresh + r.jitter(refreshInterval)}
 	}
 
 	// otherwise, requeue immediately
 	return ctrl.Result{Requeue: true}
 }
 
+// jitter returns a random delay of up to RefreshJitter percent of the refresh interval,
+// so ExternalSecrets with the same refresh interval do not hit the providers at the same time.
+func (r *Reconciler) jitter(refreshInterval time.Duration) time.Duration {
+	maxJitter := refreshInterval * time.Duration(r.RefreshJitter) / 100
+	if maxJitter <= 0 {
+		return 0
+	}
+	return rand.N(maxJitter)
+}
+
 func (r *Reconciler) markAsDone(externalSecret *esv1.ExternalSecret, start time.Time, log logr.Logger, reason, msg string) {
 	oldReadyCondition := GetExternalSecretCondition(externalSecret.Status, esv1.ExternalSecretReady)
 	newReadyCondition := NewExternalSecretCondition(esv1.ExternalSecretReady, v1.ConditionTrue, reason, msg)
@@ -607,6 +748,7 @@ func (r *Reconciler) markAsDone(externalSecret *esv1.ExternalSecret, start time.
 
 	externalSecret.Status.RefreshTime = metav1.NewTime(start)
 	externalSecret.Status.SyncedResourceVersion = ctrlutil.GetResourceVersion(externalSecret.ObjectMeta)
+	externalSecret.Status.RefreshRequestedAt = nil
 
 	// if the status or reason has changed, log at the appropriate verbosity level
 	if oldReadyCondition == nil || oldReadyCondition.Status != newReadyCondition.Status || oldReadyCondition.Reason != newReadyCondition.Reason {
@@ -627,6 +769,18 @@ func (r *Reconciler) markAsFailed(msg string, err error, externalSecret *esv1.Ex
 	counter.Inc()
 }
 
+// markAsStoreUnavailable only records an event when the ExternalSecret becomes unavailable,
+// so an outage of a provider does not flood the events of the cluster.
+func (r *Reconciler) markAsStoreUnavailable(storeUnavailable *secretstore.StoreUnavailableError, externalSecret *esv1.ExternalSecret, counter prometheus.Counter) {
+	oldReadyCondition := GetExternalSecretCondition(externalSecret.Status, esv1.ExternalSecretReady)
+	if oldReadyCondition == nil || oldReadyCondition.Reason != esv1.ConditionReasonStoreUnavailable {
+		r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1.ConditionReasonStoreUnavailable, storeUnavailable.Error())
+	}
+	conditionSynced := NewExternalSecretCondition(esv1.ExternalSecretReady, v1.ConditionFalse, esv1.ConditionReasonStoreUnavailable, storeUnavailable.Error())
+	SetExternalSecretCondition(externalSecret, *conditionSynced)
+	counter.Inc()
+}
+
 func (r *Reconciler) cleanupManagedSecrets(ctx context.Context, log logr.Logger, externalSecret *esv1.ExternalSecret) error {
 	// Only delete secrets if DeletionPolicy is Delete
 	if externalSecret.Spec.Target.DeletionPolicy != esv1.DeletionPolicyDelete {
@@ -634,6 +788,12 @@ func (r *Reconciler) cleanupManagedSecrets(ctx context.Context, log logr.Logger,
 		return nil
 	}
 
+	if externalSecret.Spec.Target.Versioning != nil {
+		if err := r.deleteAllVersions(ctx, externalSecret); err != nil {
+			return err
+		}
+	}
+
 	secretName := externalSecret.Spec.Target.Name
 	if secretName == "" {
 		secretName = externalSecret.Name
@@ -677,7 +837,11 @@ func (r *Reconciler) deleteOrphanedSecrets(ctx context.Context, externalSecret *
 	}
 
 	// delete all secrets that are not the target secret
+	// NOTE: the versions of the target secret are deleted by deleteOldVersions()
 	for _, secretPartial := range secretListPartial.Items {
+		if externalSecret.Spec.Target.Versioning != nil && secretPartial.GetLabels()[esv1.LabelVersionOf] != "" {
+			continue
+		}
 		if secretPartial.GetName() != secretName {
 			err := r.Delete(ctx, &secretPartial)
 			if err != nil && !apierrors.IsNotFound(err) {
@@ -957,6 +1121,11 @@ func shouldRefreshPeriodic(es *esv1.ExternalSecret) bool {
 		return true
 	}
 
+	// if the refresh receiver requested a refresh, we should refresh
+	if es.Status.RefreshRequestedAt != nil {
+		return true
+	}
+
 	// if the last refresh time is in the future, we should refresh
 	if es.Status.RefreshTime.Time.After(time.Now()) {
 		return true
@@ -994,6 +1163,9 @@ func isSecretValid(existingSecret *v1.Secret, es *esv1.ExternalSecret) bool {
 // SetupWithManager returns a new controller builder that will be started by the provided Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	r.recorder = mgr.GetEventRecorderFor("external-secrets")
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 
 	// index ExternalSecrets based on the target secret name,
 	// this lets us quickly find all ExternalSecrets which target a specific Secret
@@ -1008,6 +1180,9 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options)
 	}); err != nil {
 		return err
 	}
+	if err := setupDependencyIndexes(context.Background(), mgr); err != nil {
+		return err
+	}
 
 	// predicate function to ignore secret events unless they have the "managed" label
 	secretHasESLabel := predicate.NewPredicateFuncs(func(object client.Object) bool {
@@ -1015,17 +1190,21 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options)
 		return hasLabel && value == esv1.LabelManagedValue
 	})
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
-		For(&esv1.ExternalSecret{}).
+		For(&esv1.ExternalSecret{}, builder.WithPredicates(r.Shard.Predicate())).
 		// we cant use Owns(), as we don't set ownerReferences when the creationPolicy is not Owner.
 		// we use WatchesMetadata() to reduce memory usage, as otherwise we have to process full secret objects.
 		WatchesMetadata(
 			&v1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.findObjectsForSecret),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}, secretHasESLabel),
-		).
-		Complete(r)
+		)
+	if r.Shard != nil {
+		// reconcile the ExternalSecrets this replica takes over when replicas join or leave the shard group
+		b = b.WatchesRawSource(r.Shard.Source(mgr.GetClient(), &esv1.ExternalSecretList{}))
+	}
+	return r.watchDependencies(mgr, b).Complete(r)
 }
 
 func (r *Reconciler) findObjectsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
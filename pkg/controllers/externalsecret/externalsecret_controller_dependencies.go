@@ -0,0 +1,277 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	indexESSecretStoreRefField = ".spec.secretStoreRefs"
+	indexESTemplateFromField   = ".spec.target.template.templateFrom"
+	indexESGeneratorRefField   = ".spec.dataFrom.sourceRef.generatorRef"
+)
+
+// dependencyKey is the value of the dependency indexes, e.g. "ConfigMap/my-template".
+func dependencyKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// secretStoreRefs returns the stores referenced by the ExternalSecret.
+func secretStoreRefs(es *esv1.ExternalSecret) []string {
+	var keys []string
+	add := func(ref esv1.SecretStoreRef) {
+		if ref.Name == "" {
+			return
+		}
+		kind := ref.Kind
+		if kind == "" {
+			kind = esv1.SecretStoreKind
+		}
+		keys = append(keys, dependencyKey(kind, ref.Name))
+	}
+	add(es.Spec.SecretStoreRef)
+	for _, data := range es.Spec.Data {
+		if data.SourceRef != nil {
+			add(data.SourceRef.SecretStoreRef)
+		}
+	}
+	for _, data := range es.Spec.DataFrom {
+		if data.SourceRef != nil && data.SourceRef.SecretStoreRef != nil {
+			add(*data.SourceRef.SecretStoreRef)
+		}
+	}
+	return compactKeys(keys)
+}
+
+// templateFromRefs returns the ConfigMaps and Secrets referenced in the templateFrom of the ExternalSecret.
+func templateFromRefs(es *esv1.ExternalSecret) []string {
+	if es.Spec.Target.Template == nil {
+		return nil
+	}
+	var keys []string
+	for _, tpl := range es.Spec.Target.Template.TemplateFrom {
+		if tpl.ConfigMap != nil && tpl.ConfigMap.Name != "" {
+			keys = append(keys, dependencyKey("ConfigMap", tpl.ConfigMap.Name))
+		}
+		if tpl.Secret != nil && tpl.Secret.Name != "" {
+			keys = append(keys, dependencyKey("Secret", tpl.Secret.Name))
+		}
+	}
+	return compactKeys(keys)
+}
+
+// generatorRefs returns the generators referenced by the ExternalSecret.
+func generatorRefs(es *esv1.ExternalSecret) []string {
+	var keys []string
+	for _, data := range es.Spec.DataFrom {
+		if data.SourceRef != nil && data.SourceRef.GeneratorRef != nil {
+			keys = append(keys, dependencyKey(data.SourceRef.GeneratorRef.Kind, data.SourceRef.GeneratorRef.Name))
+		}
+	}
+	return compactKeys(keys)
+}
+
+func compactKeys(keys []string) []string {
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// setupDependencyIndexes indexes ExternalSecrets by the resources they depend on,
+// this lets us quickly find all ExternalSecrets which need to be refreshed when a dependency changes.
+func setupDependencyIndexes(ctx context.Context, mgr ctrl.Manager) error {
+	indexes := map[string]func(es *esv1.ExternalSecret) []string{
+		indexESSecretStoreRefField: secretStoreRefs,
+		indexESTemplateFromField:   templateFromRefs,
+		indexESGeneratorRefField:   generatorRefs,
+	}
+	for field, extract := range indexes {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, &esv1.ExternalSecret{}, field, func(obj client.Object) []string {
+			return extract(obj.(*esv1.ExternalSecret))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watchDependencies re-reconciles ExternalSecrets when a referenced store, templateFrom source or generator changes.
+func (r *Reconciler) watchDependencies(mgr ctrl.Manager, b *builder.Builder) *builder.Builder {
+	b = b.Watches(
+		&esv1.SecretStore{},
+		handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(indexESSecretStoreRefField, esv1.SecretStoreKind)),
+		builder.WithPredicates(storeChangedPredicate()),
+	)
+	if r.ClusterSecretStoreEnabled {
+		b = b.Watches(
+			&esv1.ClusterSecretStore{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(indexESSecretStoreRefField, esv1.ClusterSecretStoreKind)),
+			builder.WithPredicates(storeChangedPredicate()),
+		)
+	}
+
+	// we use WatchesMetadata() to reduce memory usage, a change of the data updates the resource version.
+	b = b.WatchesMetadata(
+		&v1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(indexESTemplateFromField, "Secret")),
+		builder.WithPredicates(updatedPredicate(predicate.ResourceVersionChangedPredicate{})),
+	)
+	// the controller does not watch ConfigMaps otherwise,
+	// so this informer for all ConfigMaps in the cluster is opt-in.
+	if r.ConfigMapWatchesEnabled {
+		b = b.WatchesMetadata(
+			&v1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(indexESTemplateFromField, "ConfigMap")),
+			builder.WithPredicates(updatedPredicate(predicate.ResourceVersionChangedPredicate{})),
+		)
+	}
+
+	if !r.GeneratorWatchesEnabled {
+		return b
+	}
+	for _, kind := range r.generatorKinds(mgr) {
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(genv1alpha1.SchemeGroupVersion.WithKind(kind))
+		b = b.WatchesMetadata(
+			obj,
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(indexESGeneratorRefField, kind)),
+			builder.WithPredicates(updatedPredicate(predicate.GenerationChangedPredicate{})),
+		)
+	}
+	return b
+}
+
+// generatorKinds returns the generator kinds which are installed in the cluster.
+func (r *Reconciler) generatorKinds(mgr ctrl.Manager) []string {
+	var kinds []string
+	for kind := range r.Scheme.KnownTypes(genv1alpha1.SchemeGroupVersion) {
+		if _, ok := genv1alpha1.GetGeneratorByName(kind); !ok && kind != genv1alpha1.ClusterGeneratorKind {
+			continue
+		}
+		gvk := genv1alpha1.SchemeGroupVersion.WithKind(kind)
+		if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+			r.Log.V(1).Info("not watching generator, kind is not installed", "kind", kind)
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// findObjectsForDependency returns a map function which enqueues all ExternalSecrets that depend on the object.
+// The ExternalSecrets are refreshed on the next reconcile, even if their refresh interval has not passed yet.
+func (r *Reconciler) findObjectsForDependency(field, kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		listOps := &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(field, dependencyKey(kind, obj.GetName())),
+		}
+		// cluster scoped dependencies are referenced from all namespaces.
+		if obj.GetNamespace() != "" {
+			listOps.Namespace = obj.GetNamespace()
+		}
+		externalSecretsList := &esv1.ExternalSecretList{}
+		if err := r.List(ctx, externalSecretsList, listOps); err != nil {
+			r.Log.Error(err, "unable to list ExternalSecrets for dependency", "kind", kind, "name", obj.GetName())
+			return []reconcile.Request{}
+		}
+
+		requests := make([]reconcile.Request, len(externalSecretsList.Items))
+		for i := range externalSecretsList.Items {
+			name := types.NamespacedName{
+				Name:      externalSecretsList.Items[i].GetName(),
+				Namespace: externalSecretsList.Items[i].GetNamespace(),
+			}
+			r.dependencyChanged.Store(name, struct{}{})
+			requests[i] = reconcile.Request{NamespacedName: name}
+		}
+		return requests
+	}
+}
+
+// takeDependencyChanged returns true if the ExternalSecret was enqueued because a dependency changed, and clears the signal.
+func (r *Reconciler) takeDependencyChanged(name types.NamespacedName) bool {
+	_, changed := r.dependencyChanged.LoadAndDelete(name)
+	return changed
+}
+
+// refreshOnDependencyChange returns true if the ExternalSecret is refreshed when a dependency changed.
+// Only ExternalSecrets that are refreshed periodically are refreshed on dependency changes.
+func refreshOnDependencyChange(es *esv1.ExternalSecret) bool {
+	switch es.Spec.RefreshPolicy {
+	case esv1.RefreshPolicyPeriodic, "":
+		return es.Spec.RefreshInterval == nil || es.Spec.RefreshInterval.Duration > 0
+	default:
+		return false
+	}
+}
+
+// updatedPredicate only passes update events of the given predicate.
+// Create events are ignored, as they are also sent for all existing objects when the controller starts.
+func updatedPredicate(p predicate.Predicate) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		UpdateFunc:  p.Update,
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// storeChangedPredicate passes updates of the store spec and stores that became ready.
+func storeChangedPredicate() predicate.Predicate {
+	return updatedPredicate(predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				return true
+			}
+			oldStore, okOld := e.ObjectOld.(esv1.GenericStore)
+			newStore, okNew := e.ObjectNew.(esv1.GenericStore)
+			if !okOld || !okNew {
+				return false
+			}
+			return !storeReady(oldStore) && storeReady(newStore)
+		},
+	})
+}
+
+func storeReady(store esv1.GenericStore) bool {
+	for _, cond := range store.GetStatus().Conditions {
+		if cond.Type == esv1.SecretStoreReady {
+			return cond.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
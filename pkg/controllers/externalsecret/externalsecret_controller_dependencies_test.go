@@ -0,0 +1,165 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func dependencyTestExternalSecret(name string) *esv1.ExternalSecret {
+	return &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: esv1.ExternalSecretSpec{
+			RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			SecretStoreRef:  esv1.SecretStoreRef{Name: "store"},
+			Target: esv1.ExternalSecretTarget{
+				Template: &esv1.ExternalSecretTemplate{
+					TemplateFrom: []esv1.TemplateFrom{
+						{ConfigMap: &esv1.TemplateRef{Name: "tpl"}},
+						{Secret: &esv1.TemplateRef{Name: "tpl-secret"}},
+					},
+				},
+			},
+			Data: []esv1.ExternalSecretData{
+				{SourceRef: &esv1.StoreSourceRef{SecretStoreRef: esv1.SecretStoreRef{Name: "global", Kind: esv1.ClusterSecretStoreKind}}},
+				{SourceRef: &esv1.StoreSourceRef{SecretStoreRef: esv1.SecretStoreRef{Name: "store"}}},
+			},
+			DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{SourceRef: &esv1.StoreGeneratorSourceRef{GeneratorRef: &esv1.GeneratorRef{Kind: "Password", Name: "pw"}}},
+			},
+		},
+	}
+}
+
+func TestDependencyRefs(t *testing.T) {
+	es := dependencyTestExternalSecret("es")
+	if diff := cmp.Diff([]string{"ClusterSecretStore/global", "SecretStore/store"}, secretStoreRefs(es)); diff != "" {
+		t.Errorf("unexpected store refs: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"ConfigMap/tpl", "Secret/tpl-secret"}, templateFromRefs(es)); diff != "" {
+		t.Errorf("unexpected templateFrom refs: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Password/pw"}, generatorRefs(es)); diff != "" {
+		t.Errorf("unexpected generator refs: -want, +got:\n%s", diff)
+	}
+}
+
+func TestFindObjectsForDependency(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+	other := dependencyTestExternalSecret("other")
+	other.Spec.Target.Template = nil
+	kube := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dependencyTestExternalSecret("es"), other).
+		WithIndex(&esv1.ExternalSecret{}, indexESTemplateFromField, func(obj client.Object) []string {
+			return templateFromRefs(obj.(*esv1.ExternalSecret))
+		}).
+		Build()
+	r := &Reconciler{Client: kube, Log: logr.Discard()}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "tpl", Namespace: "default"}}
+	got := r.findObjectsForDependency(indexESTemplateFromField, "ConfigMap")(context.Background(), cm)
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "es", Namespace: "default"}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected requests: -want, +got:\n%s", diff)
+	}
+
+	if !r.takeDependencyChanged(want[0].NamespacedName) {
+		t.Errorf("expected the dependency change to be tracked")
+	}
+	if r.takeDependencyChanged(want[0].NamespacedName) {
+		t.Errorf("expected the dependency change to be taken")
+	}
+	es := dependencyTestExternalSecret("es")
+	if !refreshOnDependencyChange(es) {
+		t.Errorf("expected periodically refreshed ExternalSecrets to be refreshed")
+	}
+	es.Spec.RefreshPolicy = esv1.RefreshPolicyCreatedOnce
+	if refreshOnDependencyChange(es) {
+		t.Errorf("expected CreatedOnce ExternalSecrets not to be refreshed")
+	}
+}
+
+func TestReconcileTakesDependencyChanged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+	kube := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &Reconciler{Client: kube, Log: logr.Discard()}
+
+	// the signal of a deleted ExternalSecret is not left behind.
+	name := types.NamespacedName{Name: "deleted", Namespace: "default"}
+	r.dependencyChanged.Store(name, struct{}{})
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: name}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.dependencyChanged.Load(name); ok {
+		t.Errorf("expected the dependency change of a deleted ExternalSecret to be cleared")
+	}
+}
+
+func TestStoreChangedPredicate(t *testing.T) {
+	store := func(generation int64, ready corev1.ConditionStatus) *esv1.SecretStore {
+		return &esv1.SecretStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "store", Generation: generation},
+			Status: esv1.SecretStoreStatus{
+				Conditions: []esv1.SecretStoreStatusCondition{{Type: esv1.SecretStoreReady, Status: ready}},
+			},
+		}
+	}
+	cases := map[string]struct {
+		old, new *esv1.SecretStore
+		want     bool
+	}{
+		"SpecChanged":   {old: store(1, corev1.ConditionTrue), new: store(2, corev1.ConditionTrue), want: true},
+		"BecameReady":   {old: store(1, corev1.ConditionFalse), new: store(1, corev1.ConditionTrue), want: true},
+		"BecameUnready": {old: store(1, corev1.ConditionTrue), new: store(1, corev1.ConditionFalse)},
+		"Unchanged":     {old: store(1, corev1.ConditionTrue), new: store(1, corev1.ConditionTrue)},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := storeChangedPredicate().Update(event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new})
+			if got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+	if storeChangedPredicate().Create(event.CreateEvent{Object: store(1, corev1.ConditionTrue)}) {
+		t.Errorf("expected create events to be ignored")
+	}
+}
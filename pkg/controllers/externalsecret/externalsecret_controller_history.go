@@ -0,0 +1,182 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+const (
+	errRollbackRevision = "invalid rollback revision %q: %w"
+	errRevisionNotFound = "revision %d is not in the history"
+	errGetRevision      = "unable to get secret %s of revision %d: %w"
+	errCreateRevision   = "unable to create secret %s of revision %d: %w"
+	errDeleteRevision   = "unable to delete secret %s of revision %d: %w"
+
+	eventRolledBack = "secret pinned to revision %d"
+
+	// defaultHistoryLimit is the number of revisions kept if spec.target.history.limit is not set.
+	defaultHistoryLimit = 5
+)
+
+// getRevisionData returns the data of the revision the rollback annotation pins the target secret to.
+// It returns false if the annotation is not set, in which case the data is fetched from the provider.
+func (r *Reconciler) getRevisionData(ctx context.Context, es *esv1.ExternalSecret) (map[string][]byte, bool, error) {
+	value := es.Annotations[esv1.AnnotationRollbackRevision]
+	if value == "" {
+		es.Status.PinnedRevision = nil
+		return nil, false, nil
+	}
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf(errRollbackRevision, value, err)
+	}
+	i := slices.IndexFunc(es.Status.Revisions, func(rev esv1.ExternalSecretRevision) bool {
+		return rev.Revision == revision
+	})
+	if i < 0 {
+		return nil, false, fmt.Errorf(errRevisionNotFound, revision)
+	}
+
+	// history secrets are not managed secrets, so they are not in the cache of managed secrets
+	secret := &v1.Secret{}
+	secretName := es.Status.Revisions[i].SecretName
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Name: secretName, Namespace: es.Namespace}, secret); err != nil {
+		return nil, false, fmt.Errorf(errGetRevision, secretName, revision, err)
+	}
+
+	if ptr.Deref(es.Status.PinnedRevision, 0) != revision {
+		r.recorder.Eventf(es, v1.EventTypeNormal, esv1.ReasonRolledBack, eventRolledBack, revision)
+	}
+	es.Status.PinnedRevision = &revision
+	return secret.Data, true, nil
+}
+
+// recordRevision adds a revision to the history when the synced data changed, together with the versions
+// of the provider secrets the data was fetched from, and deletes the oldest revisions beyond spec.target.history.limit.
+// The history is deleted when spec.target.history is not set.
+func (r *Reconciler) recordRevision(ctx context.Context, es *esv1.ExternalSecret, secretName string, dataMap map[string][]byte, sourceVersions map[string]string) error {
+	limit := 0
+	if es.Spec.Target.History != nil {
+		limit = int(ptr.Deref(es.Spec.Target.History.Limit, defaultHistoryLimit))
+	}
+
+	revisions := es.Status.Revisions
+	dataHash := esutils.ObjectHash(dataMap)
+	if n := len(revisions); limit > 0 && (n == 0 || revisions[n-1].DataHash != dataHash) {
+		revision := int64(1)
+		if n > 0 {
+			revision = revisions[n-1].Revision + 1
+		}
+		name, err := r.createRevisionSecret(ctx, es, secretName, revision, dataHash, dataMap)
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, esv1.ExternalSecretRevision{
+			Revision:       revision,
+			SecretName:     name,
+			DataHash:       dataHash,
+			CreatedAt:      metav1.Now(),
+			SourceVersions: sourceVersions,
+		})
+	}
+
+	for len(revisions) > limit {
+		err := r.Delete(ctx, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: revisions[0].SecretName, Namespace: es.Namespace}})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf(errDeleteRevision, revisions[0].SecretName, revisions[0].Revision, err)
+		}
+		revisions = revisions[1:]
+	}
+	if len(revisions) == 0 {
+		revisions = nil
+	}
+	es.Status.Revisions = revisions
+	return nil
+}
+
+// createRevisionSecret creates the immutable secret which holds the data of a revision.
+func (r *Reconciler) createRevisionSecret(ctx context.Context, es *esv1.ExternalSecret, secretName string, revision int64, dataHash string, dataMap map[string][]byte) (string, error) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretNameWithSuffix(secretName, fmt.Sprintf("history-%d", revision)),
+			Namespace: es.Namespace,
+			Labels: map[string]string{
+				esv1.LabelHistoryOf: ownerLabelFor(es),
+			},
+			Annotations: map[string]string{
+				esv1.AnnotationRevision: strconv.FormatInt(revision, 10),
+				esv1.AnnotationDataHash: dataHash,
+			},
+		},
+		Immutable: ptr.To(true),
+		Data:      dataMap,
+	}
+	if err := controllerutil.SetControllerReference(es, secret, r.Scheme); err != nil {
+		return "", fmt.Errorf(errCreateRevision, secret.Name, revision, err)
+	}
+
+	// a secret of the same revision is left behind if the status of a previous sync was not saved,
+	// it is replaced as it may hold other data.
+	fqdn := fqdnFor(es.Name)
+	err := r.Create(ctx, secret, client.FieldOwner(fqdn))
+	if apierrors.IsAlreadyExists(err) {
+		err = r.Delete(ctx, secret)
+		if err == nil {
+			err = r.Create(ctx, secret, client.FieldOwner(fqdn))
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf(errCreateRevision, secret.Name, revision, err)
+	}
+	return secret.Name, nil
+}
+
+// setSourceVersions records the version of the provider secret of ref for the given secret keys.
+// Keys without a known version are removed, as a previous entry may have set them.
+func (r *Reconciler) setSourceVersions(ctx context.Context, versions map[string]string, secretsClient esv1.SecretsClient, ref esv1.ExternalSecretDataRemoteRef, keys []string) {
+	if versions == nil {
+		return
+	}
+	version, err := secretstore.GetSecretVersion(ctx, secretsClient, ref)
+	if err != nil {
+		r.Log.V(1).Info("unable to get version of provider secret", "key", ref.Key, "error", err)
+		version = ""
+	}
+	for _, key := range keys {
+		if version == "" {
+			delete(versions, key)
+			continue
+		}
+		versions[key] = version
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestRecordRevision(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, Scheme: scheme, recorder: recorder}
+
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "uid"},
+		Spec: esv1.ExternalSecretSpec{
+			Target: esv1.ExternalSecretTarget{
+				History: &esv1.ExternalSecretTargetHistory{Limit: ptr.To[int32](2)},
+			},
+		},
+	}
+	exists := func(name string) bool {
+		t.Helper()
+		return fakeClient.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "default"}, &v1.Secret{}) == nil
+	}
+
+	for _, value := range []string{"a", "a", "b", "c"} {
+		if err := r.recordRevision(context.Background(), es, "secret", map[string][]byte{"password": []byte(value)}, map[string]string{"password": "v-" + value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// unchanged data does not add a revision and only the last two revisions are kept.
+	revisions := es.Status.Revisions
+	if len(revisions) != 2 || revisions[0].Revision != 2 || revisions[1].Revision != 3 {
+		t.Fatalf("unexpected revisions %+v", revisions)
+	}
+	if revisions[1].SecretName != "secret-history-3" || !exists("secret-history-3") {
+		t.Errorf("secret of revision 3 does not exist: %+v", revisions[1])
+	}
+	if exists("secret-history-1") {
+		t.Errorf("secret of revision 1 was not deleted")
+	}
+	if got := revisions[1].SourceVersions; len(got) != 1 || got["password"] != "v-c" {
+		t.Errorf("unexpected source versions %v", got)
+	}
+
+	// the rollback annotation pins the data to a revision.
+	es.Annotations = map[string]string{esv1.AnnotationRollbackRevision: "2"}
+	data, pinned, err := r.getRevisionData(context.Background(), es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pinned || string(data["password"]) != "b" || ptr.Deref(es.Status.PinnedRevision, 0) != 2 {
+		t.Errorf("unexpected pinned data %v (pinned %t, revision %v)", data, pinned, es.Status.PinnedRevision)
+	}
+	es.Annotations[esv1.AnnotationRollbackRevision] = "1"
+	if _, _, err := r.getRevisionData(context.Background(), es); err == nil {
+		t.Errorf("expected an error for a revision which is not in the history")
+	}
+	delete(es.Annotations, esv1.AnnotationRollbackRevision)
+	if _, pinned, err := r.getRevisionData(context.Background(), es); err != nil || pinned || es.Status.PinnedRevision != nil {
+		t.Errorf("data is still pinned after the annotation was cleared (err %v)", err)
+	}
+
+	// the history is deleted when it is disabled.
+	es.Spec.Target.History = nil
+	if err := r.recordRevision(context.Background(), es, "secret", map[string][]byte{"password": []byte("c")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.Revisions != nil || exists("secret-history-2") || exists("secret-history-3") {
+		t.Errorf("history was not deleted: %+v", es.Status.Revisions)
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+const (
+	errGetLastKnownGood  = "unable to get last known good secret %s: %w"
+	errSaveLastKnownGood = "unable to save last known good secret %s: %w"
+	errNotLastKnownGood  = "secret %s exists and does not hold the last known good values of the ExternalSecret"
+
+	// lastKnownGoodKey is the key of the last known good secret which holds the values by entry.
+	lastKnownGoodKey = "entries"
+)
+
+// lastKnownGood holds the provider values of the spec.data and spec.dataFrom entries with failurePolicy=KeepLastKnownGood.
+// The values are stored by entry in a secret owned by the ExternalSecret, as the target secret holds the rendered
+// values, which can not be mapped back to the entries when a template is used.
+type lastKnownGood struct {
+	secret   *v1.Secret
+	previous map[string]map[string][]byte
+	entries  map[string]map[string][]byte
+	// stale are the keys which were restored, with the error of their entry.
+	stale map[string]error
+}
+
+// getLastKnownGood reads the last known good values of the ExternalSecret.
+// It returns nil if the failure policy is not KeepLastKnownGood.
+func (r *Reconciler) getLastKnownGood(ctx context.Context, es *esv1.ExternalSecret) (*lastKnownGood, error) {
+	if es.Spec.FailurePolicy != esv1.FailurePolicyKeepLastKnownGood {
+		return nil, nil
+	}
+	lkg := &lastKnownGood{
+		secret: &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      lastKnownGoodSecretName(es),
+				Namespace: es.Namespace,
+			},
+		},
+		previous: make(map[string]map[string][]byte),
+		entries:  make(map[string]map[string][]byte),
+		stale:    make(map[string]error),
+	}
+
+	// the last known good secret is not a managed secret, so it is not in the cache of managed secrets
+	err := r.APIReader.Get(ctx, types.NamespacedName{Name: lkg.secret.Name, Namespace: es.Namespace}, lkg.secret)
+	if apierrors.IsNotFound(err) {
+		return lkg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errGetLastKnownGood, lkg.secret.Name, err)
+	}
+	if lkg.secret.Labels[esv1.LabelLastKnownGoodOf] != ownerLabelFor(es) {
+		return nil, fmt.Errorf(errNotLastKnownGood, lkg.secret.Name)
+	}
+	data, ok := lkg.secret.Data[lastKnownGoodKey]
+	if !ok {
+		return lkg, nil
+	}
+	if err := json.Unmarshal(data, &lkg.previous); err != nil {
+		return nil, fmt.Errorf(errGetLastKnownGood, lkg.secret.Name, err)
+	}
+	return lkg, nil
+}
+
+// keep records the values of an entry which was fetched.
+func (l *lastKnownGood) keep(id string, values map[string][]byte) {
+	if l == nil {
+		return
+	}
+	l.entries[id] = values
+}
+
+// restore returns the last known good values of an entry which could not be fetched.
+// It returns false if there are no last known good values, e.g. on the first sync or for a new entry,
+// so the error is handled like without the failure policy.
+func (l *lastKnownGood) restore(id string, fetchErr error) (map[string][]byte, bool) {
+	if l == nil {
+		return nil, false
+	}
+	values, ok := l.previous[id]
+	if !ok {
+		return nil, false
+	}
+	l.entries[id] = values
+	for key := range values {
+		l.stale[key] = fetchErr
+	}
+	return values, true
+}
+
+// staleKeys returns the keys which keep their last known good value.
+func (l *lastKnownGood) staleKeys() map[string]error {
+	if l == nil {
+		return nil
+	}
+	return l.stale
+}
+
+// saveLastKnownGood stores the values of the entries of the current sync, if they changed.
+func (r *Reconciler) saveLastKnownGood(ctx context.Context, es *esv1.ExternalSecret, lkg *lastKnownGood) error {
+	if lkg == nil || maps.EqualFunc(lkg.previous, lkg.entries, func(a, b map[string][]byte) bool {
+		return maps.EqualFunc(a, b, bytes.Equal)
+	}) {
+		return nil
+	}
+	data, err := json.Marshal(lkg.entries)
+	if err != nil {
+		return fmt.Errorf(errSaveLastKnownGood, lkg.secret.Name, err)
+	}
+
+	secret := lkg.secret
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[esv1.LabelLastKnownGoodOf] = ownerLabelFor(es)
+	secret.Data = map[string][]byte{lastKnownGoodKey: data}
+	if err := controllerutil.SetControllerReference(es, secret, r.Scheme); err != nil {
+		return fmt.Errorf(errSaveLastKnownGood, secret.Name, err)
+	}
+
+	fqdn := fqdnFor(es.Name)
+	if secret.UID == "" {
+		err = r.Create(ctx, secret, client.FieldOwner(fqdn))
+	} else {
+		err = r.Update(ctx, secret, client.FieldOwner(fqdn))
+	}
+	if err != nil {
+		return fmt.Errorf(errSaveLastKnownGood, secret.Name, err)
+	}
+	lkg.previous = lkg.entries
+	return nil
+}
+
+// setStaleKeys reports the keys which keep their last known good value in the status of the ExternalSecret.
+func setStaleKeys(externalSecret *esv1.ExternalSecret, failedKeys map[string]error) {
+	previous := make(map[string]esv1.ExternalSecretStaleKey, len(externalSecret.Status.StaleKeys))
+	for _, stale := range externalSecret.Status.StaleKeys {
+		previous[stale.SecretKey] = stale
+	}
+
+	var staleKeys []esv1.ExternalSecretStaleKey
+	for _, key := range slices.Sorted(maps.Keys(failedKeys)) {
+		since := metav1.Now()
+		if stale, ok := previous[key]; ok {
+			since = stale.Since
+		}
+		staleKeys = append(staleKeys, esv1.ExternalSecretStaleKey{
+			SecretKey: key,
+			Since:     since,
+			Message:   failedKeys[key].Error(),
+		})
+	}
+	externalSecret.Status.StaleKeys = staleKeys
+}
+
+// entryID identifies an entry of spec.data or spec.dataFrom by its definition,
+// so last known good values are not restored for an entry which was changed.
+func entryID(field string, entry any) string {
+	return field + "/" + esutils.ObjectHash(entry)
+}
+
+// lastKnownGoodSecretName returns the name of the secret which holds the last known good values of the ExternalSecret.
+func lastKnownGoodSecretName(es *esv1.ExternalSecret) string {
+	return secretNameWithSuffix(es.Name, "last-known-good")
+}
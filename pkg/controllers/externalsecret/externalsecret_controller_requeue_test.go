@@ -0,0 +1,76 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestGetRequeueResultJitter(t *testing.T) {
+	refreshInterval := time.Hour
+	tests := []struct {
+		name        string
+		jitter      int
+		refreshTime time.Time
+		min         time.Duration
+		max         time.Duration
+	}{
+		{
+			name:        "without jitter",
+			refreshTime: time.Now(),
+			min:         refreshInterval - time.Minute,
+			max:         refreshInterval,
+		},
+		{
+			name:        "with jitter",
+			jitter:      50,
+			refreshTime: time.Now(),
+			min:         refreshInterval - time.Minute,
+			max:         refreshInterval + refreshInterval/2,
+		},
+		{
+			name:   "with jitter and no refresh time",
+			jitter: 10,
+			min:    refreshInterval,
+			max:    refreshInterval + refreshInterval/10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{RefreshJitter: tt.jitter}
+			es := &esv1.ExternalSecret{
+				Spec: esv1.ExternalSecretSpec{
+					RefreshInterval: &metav1.Duration{Duration: refreshInterval},
+				},
+				Status: esv1.ExternalSecretStatus{
+					RefreshTime: metav1.NewTime(tt.refreshTime),
+				},
+			}
+			for range 100 {
+				got := r.getRequeueResult(es).RequeueAfter
+				if got < tt.min || got > tt.max {
+					t.Fatalf("getRequeueResult() = %s, want between %s and %s", got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
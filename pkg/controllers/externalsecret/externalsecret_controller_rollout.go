@@ -0,0 +1,176 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+const (
+	errRolloutList  = "unable to list workloads of spec.target.rollout[%d]: %w"
+	errRolloutPatch = "unable to roll out %s %s: %w"
+
+	eventRolledOut = "rolled out workloads because the data of secret %s changed: %s"
+
+	msgRolloutForbidden = "could not roll out workloads: the controller needs permission to get, list and patch " +
+		"deployments, statefulsets and daemonsets of the apps API group, the Helm chart grants it with rbac.workloadRollout=true"
+
+	// annotation names must not be longer than 63 characters.
+	maxAnnotationNameLength = 63
+)
+
+// rolloutWorkloads writes the data hash of the target Secret to the pod template of the workloads
+// of spec.target.rollout when the data changed, which makes them roll out new pods with the new data.
+// Workloads are not rolled out on the first sync, as their pods already start with the current data.
+func (r *Reconciler) rolloutWorkloads(ctx context.Context, externalSecret *esv1.ExternalSecret, secretName, dataHash string) error {
+	if len(externalSecret.Spec.Target.Rollout) == 0 || dataHash == "" {
+		externalSecret.Status.RolloutDataHash = ""
+		return nil
+	}
+	if externalSecret.Status.RolloutDataHash == "" || externalSecret.Status.RolloutDataHash == dataHash {
+		externalSecret.Status.RolloutDataHash = dataHash
+		return nil
+	}
+
+	key := rolloutAnnotationKey(secretName)
+	var rolledOut []string
+	for i, target := range externalSecret.Spec.Target.Rollout {
+		workloads, err := r.listRolloutWorkloads(ctx, externalSecret.Namespace, target)
+		if err != nil {
+			return fmt.Errorf(errRolloutList, i, err)
+		}
+		for _, workload := range workloads {
+			patched, err := r.patchPodTemplate(ctx, workload, key, dataHash)
+			if err != nil {
+				return fmt.Errorf(errRolloutPatch, target.Kind, workload.GetName(), err)
+			}
+			if patched {
+				rolledOut = append(rolledOut, target.Kind+"/"+workload.GetName())
+			}
+		}
+	}
+
+	externalSecret.Status.RolloutDataHash = dataHash
+	if len(rolledOut) > 0 {
+		r.recorder.Eventf(externalSecret, v1.EventTypeNormal, esv1.ReasonRolledOut, eventRolledOut, secretName, strings.Join(rolledOut, ", "))
+	}
+	return nil
+}
+
+// listRolloutWorkloads reads the workloads directly from the API server,
+// so the controller does not need to cache all workloads of the cluster.
+// Workloads that do not exist (yet) are skipped.
+func (r *Reconciler) listRolloutWorkloads(ctx context.Context, namespace string, target esv1.ExternalSecretRolloutTarget) ([]client.Object, error) {
+	var (
+		obj  client.Object
+		list client.ObjectList
+	)
+	switch target.Kind {
+	case "Deployment":
+		obj, list = &appsv1.Deployment{}, &appsv1.DeploymentList{}
+	case "StatefulSet":
+		obj, list = &appsv1.StatefulSet{}, &appsv1.StatefulSetList{}
+	case "DaemonSet":
+		obj, list = &appsv1.DaemonSet{}, &appsv1.DaemonSetList{}
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", target.Kind)
+	}
+
+	if target.Name != "" {
+		err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: target.Name}, obj)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []client.Object{obj}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(target.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.APIReader.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	var workloads []client.Object
+	err = meta.EachListItem(list, func(item runtime.Object) error {
+		workloads = append(workloads, item.(client.Object))
+		return nil
+	})
+	return workloads, err
+}
+
+// patchPodTemplate sets the annotation of the pod template of the workload to the data hash
+// and returns true if it was changed.
+func (r *Reconciler) patchPodTemplate(ctx context.Context, workload client.Object, key, dataHash string) (bool, error) {
+	var template *v1.PodTemplateSpec
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		template = &w.Spec.Template
+	case *appsv1.StatefulSet:
+		template = &w.Spec.Template
+	case *appsv1.DaemonSet:
+		template = &w.Spec.Template
+	default:
+		return false, fmt.Errorf("unsupported workload %T", workload)
+	}
+	if template.Annotations[key] == dataHash {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(workload.DeepCopyObject().(client.Object))
+	if template.Annotations == nil {
+		template.Annotations = make(map[string]string)
+	}
+	template.Annotations[key] = dataHash
+	return true, r.Patch(ctx, workload, patch)
+}
+
+// rolloutFailedMessage returns the message of the Ready condition if the workloads could not be rolled out.
+// The missing permissions are named, as the Helm chart does not grant them by default.
+func rolloutFailedMessage(err error) string {
+	if apierrors.IsForbidden(err) {
+		return msgRolloutForbidden
+	}
+	return msgErrorRollout
+}
+
+// rolloutAnnotationKey returns the pod template annotation for the secret.
+// Long secret names are shortened with a hash suffix to fit into an annotation name.
+func rolloutAnnotationKey(secretName string) string {
+	if len(secretName) <= maxAnnotationNameLength {
+		return esv1.AnnotationRolloutPrefix + secretName
+	}
+	suffix := esutils.ObjectHash(secretName)[:8]
+	return esv1.AnnotationRolloutPrefix + secretName[:maxAnnotationNameLength-len(suffix)-1] + "-" + suffix
+}
@@ -0,0 +1,166 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestRolloutWorkloads(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	labels := map[string]string{"app": "foo"}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "other"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", Labels: labels}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default", Labels: labels}},
+	).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, recorder: recorder}
+
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"},
+		Spec: esv1.ExternalSecretSpec{
+			Target: esv1.ExternalSecretTarget{
+				Rollout: []esv1.ExternalSecretRolloutTarget{
+					{Kind: "Deployment", Name: "app"},
+					{Kind: "StatefulSet", Selector: &metav1.LabelSelector{MatchLabels: labels}},
+				},
+			},
+		},
+	}
+	key := esv1.AnnotationRolloutPrefix + "secret"
+	annotation := func(obj client.Object) string {
+		t.Helper()
+		if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(obj), obj); err != nil {
+			t.Fatal(err)
+		}
+		switch w := obj.(type) {
+		case *appsv1.Deployment:
+			return w.Spec.Template.Annotations[key]
+		case *appsv1.StatefulSet:
+			return w.Spec.Template.Annotations[key]
+		case *appsv1.DaemonSet:
+			return w.Spec.Template.Annotations[key]
+		}
+		return ""
+	}
+	app := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+
+	// the first sync does not roll out the workloads.
+	if err := r.rolloutWorkloads(context.Background(), es, "secret", "hash-1"); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.RolloutDataHash != "hash-1" {
+		t.Fatalf("RolloutDataHash = %q, want hash-1", es.Status.RolloutDataHash)
+	}
+	if got := annotation(app); got != "" {
+		t.Fatalf("first sync rolled out deployment app with %q", got)
+	}
+
+	// changed data rolls out the selected workloads only.
+	if err := r.rolloutWorkloads(context.Background(), es, "secret", "hash-2"); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.RolloutDataHash != "hash-2" {
+		t.Fatalf("RolloutDataHash = %q, want hash-2", es.Status.RolloutDataHash)
+	}
+	for _, tc := range []struct {
+		obj  client.Object
+		want string
+	}{
+		{obj: app, want: "hash-2"},
+		{obj: &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}, want: "hash-2"},
+		{obj: &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}},
+		{obj: &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "other"}}},
+		{obj: &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"}}},
+	} {
+		if got := annotation(tc.obj); got != tc.want {
+			t.Errorf("%T %s: annotation = %q, want %q", tc.obj, tc.obj.GetName(), got, tc.want)
+		}
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "Deployment/app, StatefulSet/db") {
+			t.Errorf("unexpected event %q", event)
+		}
+	default:
+		t.Error("expected a RolledOut event")
+	}
+
+	// unchanged data does not roll out again.
+	if err := r.rolloutWorkloads(context.Background(), es, "secret", "hash-2"); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("unexpected event %q", <-recorder.Events)
+	}
+
+	// removing the rollout clears the status.
+	es.Spec.Target.Rollout = nil
+	if err := r.rolloutWorkloads(context.Background(), es, "secret", "hash-3"); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.RolloutDataHash != "" {
+		t.Fatalf("RolloutDataHash = %q, want empty", es.Status.RolloutDataHash)
+	}
+}
+
+func TestRolloutFailedMessage(t *testing.T) {
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "app", errors.New("no permission"))
+	if got := rolloutFailedMessage(fmt.Errorf(errRolloutPatch, "Deployment", "app", forbidden)); got != msgRolloutForbidden {
+		t.Errorf("expected the missing permission to be named, got %q", got)
+	}
+	if got := rolloutFailedMessage(errors.New("timeout")); got != msgErrorRollout {
+		t.Errorf("unexpected message %q", got)
+	}
+}
+
+func TestRolloutAnnotationKey(t *testing.T) {
+	if got := rolloutAnnotationKey("secret"); got != "rollout.external-secrets.io/secret" {
+		t.Errorf("rolloutAnnotationKey() = %q", got)
+	}
+	long := strings.Repeat("a", 100)
+	got := rolloutAnnotationKey(long)
+	name := strings.TrimPrefix(got, esv1.AnnotationRolloutPrefix)
+	if len(name) != maxAnnotationNameLength {
+		t.Errorf("rolloutAnnotationKey() = %q, want a name of %d characters", got, maxAnnotationNameLength)
+	}
+	if got == rolloutAnnotationKey(long+"b") {
+		t.Errorf("rolloutAnnotationKey() is not unique for long names")
+	}
+}
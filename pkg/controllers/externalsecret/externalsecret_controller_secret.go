@@ -21,16 +21,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
 	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/generator/ratelimit"
 	"github.com/external-secrets/external-secrets/pkg/generator/statemanager"
 
 	// Loading registered generators.
@@ -39,7 +44,21 @@ import (
 )
 
 // GetProviderSecretData returns the provider's secret data with the provided ExternalSecret.
-func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *esv1.ExternalSecret) (providerData map[string][]byte, err error) {
+// With the KeepLastKnownGood failure policy the entries that could not be fetched keep their last known good values,
+// which are returned together with the values to save as last known good once the secret was synced.
+// Entries that could not be fetched and are handled by their onError policy
+// are listed in the status of the ExternalSecret.
+// With spec.target.history the versions of the provider secrets are returned by secret key,
+// if the provider exposes them.
+func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *esv1.ExternalSecret) (providerData map[string][]byte, sourceVersions map[string]string, lkg *lastKnownGood, err error) {
+	lkg, err = r.getLastKnownGood(ctx, externalSecret)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if externalSecret.Spec.Target.History != nil {
+		sourceVersions = make(map[string]string)
+	}
+
 	// We MUST NOT create multiple instances of a provider client (mostly due to limitations with GCP)
 	// Clientmanager keeps track of the client instances
 	// that are created during the fetching process and closes clients
@@ -74,9 +93,12 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 			}
 		}()
 	}
+	var skipped []esv1.ExternalSecretSkippedData
 	providerData = make(map[string][]byte)
 	for i, remoteRef := range externalSecret.Spec.DataFrom {
 		var secretMap map[string][]byte
+		var skip, restored bool
+		err = nil
 
 		if remoteRef.Find != nil {
 			secretMap, err = r.handleFindAllSecrets(ctx, externalSecret, remoteRef, mgr, genState, i)
@@ -84,7 +106,7 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 				err = fmt.Errorf("error processing spec.dataFrom[%d].find, err: %w", i, err)
 			}
 		} else if remoteRef.Extract != nil {
-			secretMap, err = r.handleExtractSecrets(ctx, externalSecret, remoteRef, mgr, genState, i)
+			secretMap, err = r.handleExtractSecrets(ctx, externalSecret, remoteRef, mgr, genState, i, sourceVersions)
 			if err != nil {
 				err = fmt.Errorf("error processing spec.dataFrom[%d].extract, err: %w", i, err)
 			}
@@ -95,35 +117,128 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 			}
 		}
 
+		if err != nil && skipOnError(remoteRef.OnError, err) {
+			skipped = append(skipped, skippedData(fmt.Sprintf("spec.dataFrom[%d]", i), remoteRef.OnError, err))
+			secretMap = nil
+			if remoteRef.OnError == esv1.OnErrorUseDefault {
+				secretMap = make(map[string][]byte, len(remoteRef.Default))
+				for k, v := range remoteRef.Default {
+					secretMap[k] = []byte(v)
+				}
+			}
+			skip = true
+			err = nil
+		}
+		id := entryID("dataFrom", remoteRef)
+		if err != nil {
+			if values, ok := lkg.restore(id, err); ok {
+				secretMap, err = values, nil
+				restored = true
+			}
+		} else if !skip {
+			lkg.keep(id, secretMap)
+		}
 		if errors.Is(err, esv1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1.DeletionPolicyRetain {
 			r.recorder.Eventf(externalSecret, v1.EventTypeNormal, esv1.ReasonMissingProviderSecret, eventMissingProviderSecret, i)
 			continue
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
+		// only extracted secrets have a version, the keys of the other entries replace any version of a previous entry.
+		if remoteRef.Extract == nil || skip || restored {
+			for key := range secretMap {
+				delete(sourceVersions, key)
+			}
+		}
 		providerData = esutils.MergeByteMap(providerData, secretMap)
 	}
 
 	for i, secretRef := range externalSecret.Spec.Data {
-		err := r.handleSecretData(ctx, externalSecret, secretRef, providerData, mgr)
+		err := r.handleSecretData(ctx, externalSecret, secretRef, providerData, sourceVersions, mgr)
+		if err != nil {
+			delete(sourceVersions, secretRef.SecretKey)
+		}
+		if err != nil && skipOnError(secretRef.OnError, err) {
+			skipped = append(skipped, skippedData(fmt.Sprintf("spec.data[%d]", i), secretRef.OnError, err))
+			if secretRef.OnError == esv1.OnErrorUseDefault {
+				providerData[secretRef.SecretKey] = []byte(ptr.Deref(secretRef.Default, ""))
+			}
+			continue
+		}
+		id := entryID("data", secretRef)
+		if err != nil {
+			if values, ok := lkg.restore(id, err); ok {
+				maps.Copy(providerData, values)
+				continue
+			}
+		}
+		if err == nil {
+			lkg.keep(id, map[string][]byte{secretRef.SecretKey: providerData[secretRef.SecretKey]})
+		}
 		if errors.Is(err, esv1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1.DeletionPolicyRetain {
 			r.recorder.Eventf(externalSecret, v1.EventTypeNormal, esv1.ReasonMissingProviderSecret, eventMissingProviderSecretKey, i, secretRef.RemoteRef.Key)
 			continue
 		}
 		if err != nil {
-			return nil, fmt.Errorf("error processing spec.data[%d] (key: %s), err: %w", i, secretRef.RemoteRef.Key, err)
+			return nil, nil, nil, fmt.Errorf("error processing spec.data[%d] (key: %s), err: %w", i, secretRef.RemoteRef.Key, err)
 		}
 	}
 
-	return providerData, nil
+	externalSecret.Status.SkippedData = skipped
+	return providerData, sourceVersions, lkg, nil
+}
+
+// skipOnError returns true if an entry that could not be fetched should not fail the sync.
+// Only errors of the entry itself are skipped. Errors of the store, like an open circuit breaker,
+// an outage of the provider or rejected credentials, fail the sync for all entries,
+// so the target secret is not changed while the store is unavailable.
+func skipOnError(onError esv1.ExternalSecretOnError, err error) bool {
+	if onError != esv1.OnErrorIgnore && onError != esv1.OnErrorUseDefault {
+		return false
+	}
+	var clientErr *storeClientError
+	return !errors.As(err, &clientErr) &&
+		!secretstore.IsStoreError(err) &&
+		!errors.Is(err, ratelimit.ErrRateLimited) &&
+		!errors.Is(err, context.Canceled)
 }
 
-func (r *Reconciler) handleSecretData(ctx context.Context, externalSecret *esv1.ExternalSecret, secretRef esv1.ExternalSecretData, providerData map[string][]byte, cmgr *secretstore.Manager) error {
+// storeClientError is returned if the client of the store of an entry could not be created.
+type storeClientError struct {
+	err error
+}
+
+func (e *storeClientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *storeClientError) Unwrap() error {
+	return e.err
+}
+
+func skippedData(path string, onError esv1.ExternalSecretOnError, err error) esv1.ExternalSecretSkippedData {
+	return esv1.ExternalSecretSkippedData{
+		Path:    path,
+		OnError: onError,
+		Message: err.Error(),
+	}
+}
+
+// staleness returns the time since the oldest stale key of the ExternalSecret could not be fetched.
+func staleness(externalSecret *esv1.ExternalSecret) time.Duration {
+	var oldest time.Duration
+	for _, stale := range externalSecret.Status.StaleKeys {
+		oldest = max(oldest, time.Since(stale.Since.Time))
+	}
+	return oldest
+}
+
+func (r *Reconciler) handleSecretData(ctx context.Context, externalSecret *esv1.ExternalSecret, secretRef esv1.ExternalSecretData, providerData map[string][]byte, sourceVersions map[string]string, cmgr *secretstore.Manager) error {
 	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, toStoreGenSourceRef(secretRef.SourceRef))
 	if err != nil {
-		return err
+		return &storeClientError{err: err}
 	}
 
 	// get a single secret from the store
@@ -140,6 +255,7 @@ func (r *Reconciler) handleSecretData(ctx context.Context, externalSecret *esv1.
 
 	// store the secret data
 	providerData[secretRef.SecretKey] = secretData
+	r.setSourceVersions(ctx, sourceVersions, client, secretRef.RemoteRef, []string{secretRef.SecretKey})
 
 	return nil
 }
@@ -165,17 +281,37 @@ func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string
 			return nil, fmt.Errorf("unable to get latest state: %w", err)
 		}
 	}
-	secretMap, newState, err := impl.Generate(ctx, generatorResource, r.Client, namespace)
-	if err != nil {
-		return nil, fmt.Errorf(errGenerate, err)
-	}
+	policy := statemanager.NewRegenerationPolicy(remoteRef.SourceRef.GeneratorRef)
+	var secretMap map[string][]byte
 	if latestState != nil {
-		if generatorState != nil {
-			generatorState.EnqueueMoveStateToGC(generatorStateKey(i))
+		// re-use the previously generated values if they are still young enough
+		secretMap, err = generatorState.ReuseLatest(ctx, latestState, generatorResource, policy)
+		if err != nil {
+			return nil, fmt.Errorf(errReuse, err)
 		}
 	}
-	if generatorState != nil {
-		generatorState.EnqueueSetLatest(ctx, generatorStateKey(i), namespace, generatorResource, impl, newState)
+	if secretMap == nil && latestState != nil {
+		// re-use the previously generated values if the generator was able to renew them
+		secretMap, err = generatorState.RenewLatest(ctx, latestState, generatorResource, impl)
+		if err != nil {
+			return nil, fmt.Errorf(errRenew, err)
+		}
+	}
+	if secretMap == nil {
+		if err := ratelimit.AllowResource(generatorResource); err != nil {
+			return nil, fmt.Errorf(errGenerate, err)
+		}
+		var newState genv1alpha1.GeneratorProviderState
+		secretMap, newState, err = impl.Generate(ctx, generatorResource, r.Client, namespace)
+		if err != nil {
+			return nil, fmt.Errorf(errGenerate, err)
+		}
+		if latestState != nil {
+			generatorState.EnqueueMoveStateToGC(generatorStateKey(i), statemanager.NewRetention(remoteRef.SourceRef.GeneratorRef))
+		}
+		if generatorState != nil {
+			generatorState.EnqueueSetLatest(ctx, generatorStateKey(i), namespace, generatorResource, impl, newState, secretMap, policy.Enabled())
+		}
 	}
 	// rewrite the keys if needed
 	secretMap, err = esutils.RewriteMap(remoteRef.Rewrite, secretMap)
@@ -192,6 +328,24 @@ func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string
 	return secretMap, err
 }
 
+// setGeneratorStates lists the generator states that are owned by the ExternalSecret in its status.
+func (r *Reconciler) setGeneratorStates(ctx context.Context, externalSecret *esv1.ExternalSecret) error {
+	if !r.EnableGeneratorState {
+		return nil
+	}
+	genState := statemanager.New(ctx, r.Client, r.Scheme, externalSecret.Namespace, externalSecret)
+	var summaries []esv1.GeneratorStateSummary
+	for i := range externalSecret.Spec.DataFrom {
+		states, err := genState.GetAllStates(generatorStateKey(i))
+		if err != nil {
+			return err
+		}
+		summaries = append(summaries, statemanager.Summarize(generatorStateKey(i), states)...)
+	}
+	externalSecret.Status.GeneratorStates = summaries
+	return nil
+}
+
 // We're using the index of the generator as the key for the generator state
 // this is because we can have multiple generators in the same ExternalSecret
 // and we need to keep track of the state of each generator.
@@ -199,10 +353,10 @@ func generatorStateKey(i int) string {
 	return strconv.Itoa(i)
 }
 
-func (r *Reconciler) handleExtractSecrets(ctx context.Context, externalSecret *esv1.ExternalSecret, remoteRef esv1.ExternalSecretDataFromRemoteRef, cmgr *secretstore.Manager, genState *statemanager.Manager, i int) (map[string][]byte, error) {
+func (r *Reconciler) handleExtractSecrets(ctx context.Context, externalSecret *esv1.ExternalSecret, remoteRef esv1.ExternalSecretDataFromRemoteRef, cmgr *secretstore.Manager, genState *statemanager.Manager, i int, sourceVersions map[string]string) (map[string][]byte, error) {
 	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
 	if err != nil {
-		return nil, err
+		return nil, &storeClientError{err: err}
 	}
 
 	// get multiple secrets from the store
@@ -237,13 +391,14 @@ func (r *Reconciler) handleExtractSecrets(ctx context.Context, externalSecret *e
 	if genState != nil {
 		genState.EnqueueFlagLatestStateForGC(generatorStateKey(i))
 	}
+	r.setSourceVersions(ctx, sourceVersions, client, *remoteRef.Extract, slices.Collect(maps.Keys(secretMap)))
 	return secretMap, nil
 }
 
 func (r *Reconciler) handleFindAllSecrets(ctx context.Context, externalSecret *esv1.ExternalSecret, remoteRef esv1.ExternalSecretDataFromRemoteRef, cmgr *secretstore.Manager, genState *statemanager.Manager, i int) (map[string][]byte, error) {
 	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
 	if err != nil {
-		return nil, err
+		return nil, &storeClientError{err: err}
 	}
 
 	// get all secrets from the store that match the selector
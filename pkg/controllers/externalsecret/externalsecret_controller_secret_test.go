@@ -0,0 +1,336 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+)
+
+func TestSetStaleKeys(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	t.Run("KeepsSince", func(t *testing.T) {
+		es := &esv1.ExternalSecret{
+			Status: esv1.ExternalSecretStatus{
+				StaleKeys: []esv1.ExternalSecretStaleKey{
+					{SecretKey: "db", Since: since, Message: "timeout"},
+					{SecretKey: "recovered", Since: since, Message: "timeout"},
+				},
+			},
+		}
+		setStaleKeys(es, map[string]error{
+			"db":  errors.New("unavailable"),
+			"api": esv1.NoSecretErr,
+		})
+		if len(es.Status.StaleKeys) != 2 {
+			t.Fatalf("expected 2 stale keys, got %v", es.Status.StaleKeys)
+		}
+		if es.Status.StaleKeys[0].SecretKey != "api" || es.Status.StaleKeys[0].Message != esv1.NoSecretErr.Error() {
+			t.Errorf("unexpected stale key: %v", es.Status.StaleKeys[0])
+		}
+		if diff := cmp.Diff(esv1.ExternalSecretStaleKey{SecretKey: "db", Since: since, Message: "unavailable"}, es.Status.StaleKeys[1]); diff != "" {
+			t.Errorf("expected the stale key to keep its time: -want, +got:\n%s", diff)
+		}
+		if got := staleness(es); got < time.Hour {
+			t.Errorf("expected a staleness of at least one hour, got %v", got)
+		}
+	})
+
+	t.Run("ClearsStaleKeys", func(t *testing.T) {
+		es := &esv1.ExternalSecret{
+			Status: esv1.ExternalSecretStatus{
+				StaleKeys: []esv1.ExternalSecretStaleKey{{SecretKey: "db", Since: since}},
+			},
+		}
+		setStaleKeys(es, nil)
+		if es.Status.StaleKeys != nil || staleness(es) != 0 {
+			t.Errorf("expected no stale keys, got %v", es.Status.StaleKeys)
+		}
+	})
+}
+
+func TestKeepLastKnownGood(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	store := &esv1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{
+				Fake: &esv1.FakeProvider{
+					Data: []esv1.FakeProviderData{
+						{Key: "db", Value: "db-password"},
+						{Key: "api", Value: `{"token":"api-token"}`},
+					},
+				},
+			},
+		},
+	}
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "es"},
+		Spec: esv1.ExternalSecretSpec{
+			FailurePolicy:   esv1.FailurePolicyKeepLastKnownGood,
+			SecretStoreRef:  esv1.SecretStoreRef{Name: "fake", Kind: esv1.SecretStoreKind},
+			RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			Target: esv1.ExternalSecretTarget{
+				Template: &esv1.ExternalSecretTemplate{
+					EngineVersion: esv1.TemplateEngineV2,
+					Data:          map[string]string{"url": "postgres://app:{{ .password }}@db/{{ .token }}"},
+				},
+			},
+			Data: []esv1.ExternalSecretData{
+				{SecretKey: "password", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}},
+			},
+			DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "api"}},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build()
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, Scheme: scheme, recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+
+	// the first sync stores the provider values of the entries.
+	dataMap, _, lkg, err := r.GetProviderSecretData(ctx, es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lkg.staleKeys()) != 0 {
+		t.Errorf("expected no stale keys, got %v", lkg.staleKeys())
+	}
+	if err := r.saveLastKnownGood(ctx, es, lkg); err != nil {
+		t.Fatal(err)
+	}
+	secret := &v1.Secret{}
+	if err := r.ApplyTemplate(ctx, es, secret, dataMap); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]byte{"url": []byte("postgres://app:db-password@db/api-token")}
+	if diff := cmp.Diff(want, secret.Data); diff != "" {
+		t.Errorf("unexpected data: -want, +got:\n%s", diff)
+	}
+
+	// the entries can not be fetched anymore, their provider values are restored and rendered again.
+	store.Spec.Provider.Fake.Data = nil
+	if err := fakeClient.Update(ctx, store); err != nil {
+		t.Fatal(err)
+	}
+	dataMap, _, lkg, err = r.GetProviderSecretData(ctx, es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"password", "token"}, slices.Sorted(maps.Keys(lkg.staleKeys()))); diff != "" {
+		t.Errorf("unexpected stale keys: -want, +got:\n%s", diff)
+	}
+	secret = &v1.Secret{}
+	if err := r.ApplyTemplate(ctx, es, secret, dataMap); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, secret.Data); diff != "" {
+		t.Errorf("expected the last known good values to be rendered: -want, +got:\n%s", diff)
+	}
+
+	// a changed entry has no last known good values, so its error is handled like without the failure policy.
+	es.Spec.Data[0].RemoteRef.Key = "other"
+	dataMap, _, _, err = r.GetProviderSecretData(ctx, es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := dataMap["password"]; ok {
+		t.Errorf("expected the missing entry to be skipped, got %v", dataMap)
+	}
+}
+
+func TestKeepLastKnownGoodFirstSync(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	store := &esv1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{
+				Fake: &esv1.FakeProvider{
+					Data: []esv1.FakeProviderData{{Key: "db", Value: "db-password"}},
+				},
+			},
+		},
+	}
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "es"},
+		Spec: esv1.ExternalSecretSpec{
+			FailurePolicy:  esv1.FailurePolicyKeepLastKnownGood,
+			SecretStoreRef: esv1.SecretStoreRef{Name: "fake", Kind: esv1.SecretStoreKind},
+			Data: []esv1.ExternalSecretData{
+				{SecretKey: "password", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}},
+				{SecretKey: "token", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "missing"}},
+			},
+			DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "missing"}},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build()
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, Scheme: scheme, recorder: record.NewFakeRecorder(10)}
+
+	// provider secrets which do not exist on the first sync are skipped, as without the failure policy.
+	dataMap, _, lkg, err := r.GetProviderSecretData(context.Background(), es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]byte{"password": []byte("db-password")}
+	if diff := cmp.Diff(want, dataMap); diff != "" {
+		t.Errorf("unexpected data: -want, +got:\n%s", diff)
+	}
+	if len(lkg.staleKeys()) != 0 {
+		t.Errorf("expected no stale keys, got %v", lkg.staleKeys())
+	}
+
+	// with deletionPolicy=Retain a missing provider secret fails the sync.
+	es.Spec.Target.DeletionPolicy = esv1.DeletionPolicyRetain
+	if _, _, _, err := r.GetProviderSecretData(context.Background(), es); !errors.Is(err, esv1.NoSecretErr) {
+		t.Errorf("expected a NoSecretErr, got %v", err)
+	}
+}
+
+func TestSourceVersions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	store := &esv1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{
+				Fake: &esv1.FakeProvider{
+					Data: []esv1.FakeProviderData{
+						{Key: "db", Value: "db-password", Version: "v3"},
+						{Key: "user", Value: "app"},
+						{Key: "api", Value: `{"token":"api-token","url":"https://api"}`, Version: "v7"},
+					},
+				},
+			},
+		},
+	}
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"},
+		Spec: esv1.ExternalSecretSpec{
+			SecretStoreRef: esv1.SecretStoreRef{Name: "fake", Kind: esv1.SecretStoreKind},
+			Target: esv1.ExternalSecretTarget{
+				History: &esv1.ExternalSecretTargetHistory{},
+			},
+			Data: []esv1.ExternalSecretData{
+				{SecretKey: "password", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db", Version: "v3"}},
+				{SecretKey: "user", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "user"}},
+				// replaces the extracted key, which has no version anymore
+				{SecretKey: "url", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "user"}},
+			},
+			DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "api", Version: "v7"}},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build()
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, Scheme: scheme, recorder: record.NewFakeRecorder(10)}
+
+	_, versions, _, err := r.GetProviderSecretData(context.Background(), es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"password": "v3", "token": "v7"}
+	if diff := cmp.Diff(want, versions); diff != "" {
+		t.Errorf("unexpected source versions: -want, +got:\n%s", diff)
+	}
+
+	// the versions are only collected for the history.
+	es.Spec.Target.History = nil
+	if _, versions, _, err := r.GetProviderSecretData(context.Background(), es); err != nil || versions != nil {
+		t.Errorf("unexpected source versions %v (err %v)", versions, err)
+	}
+}
+
+func TestSkipOnErrorStoreUnavailable(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	secretstore.SetCircuitBreaker(1, time.Hour, time.Hour)
+	t.Cleanup(func() {
+		secretstore.SetCircuitBreaker(0, 0, 0)
+		fakeProvider.Reset()
+	})
+
+	// the store is backed by the fake provider registered for AWS SecretsManager.
+	store := &esv1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{
+				AWS: &esv1.AWSProvider{Service: esv1.AWSServiceSecretsManager},
+			},
+		},
+	}
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"},
+		Spec: esv1.ExternalSecretSpec{
+			SecretStoreRef: esv1.SecretStoreRef{Name: "aws", Kind: esv1.SecretStoreKind},
+			Data: []esv1.ExternalSecretData{
+				{SecretKey: "password", RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}, OnError: esv1.OnErrorIgnore},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build()
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, Scheme: scheme, recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+
+	// errors of the entry are skipped.
+	fakeProvider.WithGetSecret(nil, errors.New("property password does not exist"))
+	dataMap, _, _, err := r.GetProviderSecretData(ctx, es)
+	if err != nil || len(dataMap) != 0 || len(es.Status.SkippedData) != 1 {
+		t.Fatalf("expected the entry to be skipped, got %v, %v (err %v)", dataMap, es.Status.SkippedData, err)
+	}
+
+	// an outage of the provider fails the sync and opens the circuit breaker.
+	fakeProvider.WithGetSecret(nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+	if _, _, _, err := r.GetProviderSecretData(ctx, es); err == nil {
+		t.Fatal("expected an outage of the provider to fail the sync")
+	}
+
+	// the open circuit breaker is not skipped either.
+	_, _, _, err = r.GetProviderSecretData(ctx, es)
+	var storeUnavailable *secretstore.StoreUnavailableError
+	if !errors.As(err, &storeUnavailable) {
+		t.Errorf("expected a StoreUnavailableError, got %v", err)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
@@ -1874,6 +1875,46 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// when a provider errors in a GetSecret call
+	// and the entry has onError=UseDefault the default value must be synced
+	// and the entry must be listed in the status.
+	providerErrUseDefault := func(tc *testCase) {
+		const defaultVal = "default"
+		fakeProvider.WithGetSecret(nil, errors.New("boom"))
+		tc.externalSecret.Spec.Data[0].OnError = esv1.OnErrorUseDefault
+		tc.externalSecret.Spec.Data[0].Default = ptr.To(defaultVal)
+		tc.checkSecret = func(es *esv1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[targetProp])).To(Equal(defaultVal))
+			Expect(es.Status.SkippedData).To(HaveLen(1))
+			Expect(es.Status.SkippedData[0].Path).To(Equal("spec.data[0]"))
+			Expect(es.Status.SkippedData[0].OnError).To(Equal(esv1.OnErrorUseDefault))
+		}
+	}
+
+	// when a provider errors in a GetSecret call
+	// and the entry has onError=Ignore the entry must be skipped.
+	providerErrIgnore := func(tc *testCase) {
+		fakeProvider.WithGetSecret(nil, errors.New("boom"))
+		fakeProvider.WithGetSecretMap(map[string][]byte{
+			"foo": []byte(FooValue),
+		}, nil)
+		tc.externalSecret.Spec.Data[0].OnError = esv1.OnErrorIgnore
+		tc.externalSecret.Spec.DataFrom = []esv1.ExternalSecretDataFromRemoteRef{
+			{
+				Extract: &esv1.ExternalSecretDataRemoteRef{
+					Key: remoteKey,
+				},
+			},
+		}
+		tc.checkSecret = func(es *esv1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data["foo"])).To(Equal(FooValue))
+			Expect(secret.Data).ToNot(HaveKey(targetProp))
+			Expect(es.Status.SkippedData).To(HaveLen(1))
+			Expect(es.Status.SkippedData[0].Path).To(Equal("spec.data[0]"))
+			Expect(es.Status.SkippedData[0].OnError).To(Equal(esv1.OnErrorIgnore))
+		}
+	}
+
 	// When a ExternalSecret references an non-existing SecretStore
 	// a error condition must be set.
 	storeMissingErrCondition := func(tc *testCase) {
@@ -2403,6 +2444,8 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		Entry("should not automatically convert from find if rewrite is used", invalidFindKeysErrCondition),
 		Entry("should fetch secret using dataFrom and a template", syncWithDataFromTemplate),
 		Entry("should set error condition when provider errors", providerErrCondition),
+		Entry("should sync the default value when provider errors and onError=UseDefault", providerErrUseDefault),
+		Entry("should skip the entry when provider errors and onError=Ignore", providerErrIgnore),
 		Entry("should set an error condition when store does not exist", storeMissingErrCondition),
 		Entry("should set an error condition when store provider constructor fails", storeConstructErrCondition),
 		Entry("should not process store with mismatching controller field", ignoreMismatchController),
@@ -2553,6 +2596,26 @@ var _ = Describe("ExternalSecret refresh logic", func() {
 			Expect(shouldRefresh(es)).To(BeTrue())
 		})
 
+		It("should refresh when the refresh receiver requested a refresh", func() {
+			es := &esv1.ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: esv1.ExternalSecretSpec{
+					RefreshInterval: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: esv1.ExternalSecretStatus{
+					RefreshTime: metav1.Now(),
+				},
+			}
+			es.Status.SyncedResourceVersion = ctrlutil.GetResourceVersion(es.ObjectMeta)
+			Expect(shouldRefresh(es)).To(BeFalse())
+
+			now := metav1.Now()
+			es.Status.RefreshRequestedAt = &now
+			Expect(shouldRefresh(es)).To(BeTrue())
+		})
+
 	})
 	Context("objectmeta hash", func() {
 		It("should produce different hashes for different k/v pairs", func() {
@@ -0,0 +1,237 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+const (
+	errCreateVersion  = "unable to create secret version %s: %w"
+	errGetVersion     = "unable to get secret version %s: %w"
+	errForeignVersion = "secret %s already exists and is not a version of this ExternalSecret"
+	errListVersions   = "unable to list secret versions: %w"
+	errDeleteVersion  = "unable to delete secret version %s: %w"
+
+	eventCreatedVersion = "secret version %s created"
+	eventDeletedVersion = "secret version %s deleted"
+
+	// defaultRetainedVersions is the number of previous versions kept if spec.target.versioning.retain is not set.
+	defaultRetainedVersions = 2
+
+	// versionHashLength is the length of the hash suffix of the name of a secret version.
+	versionHashLength = 10
+
+	// secret names must not be longer than 253 characters.
+	maxSecretNameLength = 253
+)
+
+// syncVersionedSecret creates an immutable version of the target secret named after the hash of its data,
+// keeps the alias secret up to date if requested and deletes the versions beyond the retention.
+func (r *Reconciler) syncVersionedSecret(ctx context.Context, mutationFunc func(secret *v1.Secret) error, es *esv1.ExternalSecret, secretName string) error {
+	fqdn := fqdnFor(es.Name)
+
+	// define and mutate the new version
+	newSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: es.Namespace,
+		},
+		Data: make(map[string][]byte),
+	}
+	if err := mutationFunc(newSecret); err != nil {
+		return err
+	}
+	newSecret.Name = versionedSecretName(secretName, newSecret)
+	newSecret.Immutable = ptr.To(true)
+	newSecret.Labels[esv1.LabelVersionOf] = ownerLabelFor(es)
+
+	// a version with the same name has the same data, so it only needs to be created once
+	err := r.Create(ctx, newSecret, client.FieldOwner(fqdn))
+	switch {
+	case err == nil:
+		r.recorder.Eventf(es, v1.EventTypeNormal, esv1.ReasonCreated, eventCreatedVersion, newSecret.Name)
+	case apierrors.IsAlreadyExists(err):
+		if err := r.checkExistingVersion(ctx, es, newSecret.Name); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf(errCreateVersion, newSecret.Name, err)
+	}
+
+	if es.Spec.Target.Versioning.Alias {
+		alias := &v1.Secret{}
+		err = r.SecretClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: es.Namespace}, alias)
+		switch {
+		case apierrors.IsNotFound(err):
+			err = r.createSecret(ctx, mutationFunc, es, secretName)
+		case err == nil:
+			err = r.updateSecret(ctx, alias, mutationFunc, es, secretName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// the binding always points to the current version
+	es.Status.Binding = v1.LocalObjectReference{Name: newSecret.Name}
+	es.Status.CurrentSecretName = newSecret.Name
+
+	return r.deleteOldVersions(ctx, es, newSecret.Name)
+}
+
+// deleteOldVersions deletes the oldest versions of the target secret,
+// keeping the current version and the number of previous versions of spec.target.versioning.retain.
+func (r *Reconciler) deleteOldVersions(ctx context.Context, es *esv1.ExternalSecret, currentName string) error {
+	versions, err := r.listVersions(ctx, es)
+	if err != nil {
+		return err
+	}
+
+	// newest versions first
+	slices.SortFunc(versions, func(a, b metav1.PartialObjectMetadata) int {
+		if c := b.CreationTimestamp.Compare(a.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	retain := int(ptr.Deref(es.Spec.Target.Versioning.Retain, defaultRetainedVersions))
+	for _, version := range versions {
+		if version.Name == currentName {
+			continue
+		}
+		if retain > 0 {
+			retain--
+			continue
+		}
+		err := r.Delete(ctx, &version)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf(errDeleteVersion, version.Name, err)
+		}
+		r.recorder.Eventf(es, v1.EventTypeNormal, esv1.ReasonDeleted, eventDeletedVersion, version.Name)
+	}
+	return nil
+}
+
+// deleteAllVersions deletes every version of the target secret, regardless of the retention.
+// It is used when the target secret is deleted because of spec.target.deletionPolicy=Delete.
+func (r *Reconciler) deleteAllVersions(ctx context.Context, es *esv1.ExternalSecret) error {
+	versions, err := r.listVersions(ctx, es)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		err := r.Delete(ctx, &version)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf(errDeleteVersion, version.Name, err)
+		}
+		r.recorder.Eventf(es, v1.EventTypeNormal, esv1.ReasonDeleted, eventDeletedVersion, version.Name)
+	}
+	return nil
+}
+
+// checkExistingVersion makes sure that an existing secret with the name of the new version
+// was created by the ExternalSecret, and not by someone else to inject other data.
+func (r *Reconciler) checkExistingVersion(ctx context.Context, es *esv1.ExternalSecret, name string) error {
+	existing := &v1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Name: name, Namespace: es.Namespace}, existing); err != nil {
+		return fmt.Errorf(errGetVersion, name, err)
+	}
+	if existing.Labels[esv1.LabelVersionOf] != ownerLabelFor(es) || !metav1.IsControlledBy(existing, es) {
+		return fmt.Errorf(errForeignVersion, name)
+	}
+	return nil
+}
+
+// deleteAlias deletes the alias secret of a versioned target secret, if it is owned by the ExternalSecret.
+func (r *Reconciler) deleteAlias(ctx context.Context, es *esv1.ExternalSecret, secretName string) error {
+	alias := &v1.Secret{}
+	err := r.SecretClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: es.Namespace}, alias)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(alias, es) {
+		return nil
+	}
+	err = r.Delete(ctx, alias)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// listVersions lists the metadata of all versions of the target secret of the given ExternalSecret.
+func (r *Reconciler) listVersions(ctx context.Context, es *esv1.ExternalSecret) ([]metav1.PartialObjectMetadata, error) {
+	secretListPartial := &metav1.PartialObjectMetadataList{}
+	secretListPartial.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("SecretList"))
+	listOpts := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			esv1.LabelVersionOf: ownerLabelFor(es),
+		}),
+		Namespace: es.Namespace,
+	}
+	if err := r.List(ctx, secretListPartial, listOpts); err != nil {
+		return nil, fmt.Errorf(errListVersions, err)
+	}
+	return secretListPartial.Items, nil
+}
+
+// versionedSecretName returns the name of the version of the target secret with the data of the given secret.
+// The secret type is part of the hash, as it can not be changed on an existing secret.
+func versionedSecretName(secretName string, secret *v1.Secret) string {
+	hash := esutils.ObjectHash(fmt.Sprintf("%s/%s", secret.Type, secret.Annotations[esv1.AnnotationDataHash]))[:versionHashLength]
+	return secretNameWithSuffix(secretName, hash)
+}
+
+// secretNameWithSuffix appends the suffix to the secret name, truncating the name to the maximum length of secret names.
+func secretNameWithSuffix(secretName, suffix string) string {
+	if maxLength := maxSecretNameLength - len(suffix) - 1; len(secretName) > maxLength {
+		secretName = strings.TrimRight(secretName[:maxLength], "-.")
+	}
+	return secretName + "-" + suffix
+}
+
+// ownedSecretName returns the name of the secret which is not orphaned when using creationPolicy=Owner.
+// With versioning, the secret with the target name is only kept as alias.
+func ownedSecretName(es *esv1.ExternalSecret, secretName string) string {
+	if es.Spec.Target.Versioning != nil && !es.Spec.Target.Versioning.Alias {
+		return ""
+	}
+	return secretName
+}
+
+// ownerLabelFor returns the value of the owner labels of the secrets of the ExternalSecret.
+func ownerLabelFor(es *esv1.ExternalSecret) string {
+	return esutils.ObjectHash(fmt.Sprintf("%v/%v", es.Namespace, es.Name))
+}
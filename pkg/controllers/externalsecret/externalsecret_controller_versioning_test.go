@@ -0,0 +1,250 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+func TestSyncVersionedSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "es"},
+		Spec: esv1.ExternalSecretSpec{
+			Target: esv1.ExternalSecretTarget{
+				Name:           "secret",
+				CreationPolicy: esv1.CreatePolicyOwner,
+				Versioning:     &esv1.ExternalSecretTargetVersioning{Retain: ptr.To[int32](1), Alias: true},
+			},
+		},
+	}
+	version := func(name string, age time.Duration) *v1.Secret {
+		return &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			Labels:            map[string]string{esv1.LabelVersionOf: ownerLabelFor(es)},
+		}}
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		version("secret-old", 2*time.Hour),
+		version("secret-previous", time.Hour),
+		// the fake client does not set the UID which updateSecret requires
+		&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default", UID: "alias"}},
+	).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, recorder: recorder}
+
+	data := map[string][]byte{"foo": []byte("bar")}
+	mutationFunc := func(secret *v1.Secret) error {
+		secret.Data = data
+		secret.Labels = map[string]string{esv1.LabelManaged: esv1.LabelManagedValue}
+		secret.Annotations = map[string]string{esv1.AnnotationDataHash: esutils.ObjectHash(secret.Data)}
+		return controllerutil.SetControllerReference(es, secret, scheme)
+	}
+
+	if err := r.syncVersionedSecret(context.Background(), mutationFunc, es, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	current := es.Status.CurrentSecretName
+	if !strings.HasPrefix(current, "secret-") || len(current) != len("secret-")+versionHashLength {
+		t.Fatalf("CurrentSecretName = %q, want secret-<hash>", current)
+	}
+	if es.Status.Binding.Name != current {
+		t.Errorf("Binding = %q, want %q", es.Status.Binding.Name, current)
+	}
+
+	got := &v1.Secret{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: current, Namespace: "default"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if !ptr.Deref(got.Immutable, false) || string(got.Data["foo"]) != "bar" {
+		t.Errorf("unexpected version %v", got)
+	}
+	alias := &v1.Secret{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "secret", Namespace: "default"}, alias); err != nil {
+		t.Fatal(err)
+	}
+	if ptr.Deref(alias.Immutable, false) || string(alias.Data["foo"]) != "bar" {
+		t.Errorf("unexpected alias %v", alias)
+	}
+
+	// only the newest previous version is retained.
+	for name, want := range map[string]bool{"secret-previous": true, "secret-old": false} {
+		err := fakeClient.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "default"}, &v1.Secret{})
+		if exists := err == nil; exists != want {
+			t.Errorf("version %s exists = %t, want %t (err %v)", name, exists, want, err)
+		}
+	}
+
+	// unchanged data keeps the current version.
+	if err := r.syncVersionedSecret(context.Background(), mutationFunc, es, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.CurrentSecretName != current {
+		t.Errorf("CurrentSecretName = %q, want %q", es.Status.CurrentSecretName, current)
+	}
+
+	// changed data creates a new version.
+	data = map[string][]byte{"foo": []byte("baz")}
+	if err := r.syncVersionedSecret(context.Background(), mutationFunc, es, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if es.Status.CurrentSecretName == current {
+		t.Errorf("CurrentSecretName was not changed for new data")
+	}
+}
+
+func TestSyncVersionedSecretForeignVersion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "es"},
+		Spec: esv1.ExternalSecretSpec{
+			Target: esv1.ExternalSecretTarget{
+				Name:           "secret",
+				CreationPolicy: esv1.CreatePolicyOwner,
+				Versioning:     &esv1.ExternalSecretTargetVersioning{},
+			},
+		},
+	}
+	other := &esv1.ExternalSecret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other"}}
+	mutationFunc := func(secret *v1.Secret) error {
+		secret.Data = map[string][]byte{"foo": []byte("bar")}
+		secret.Labels = map[string]string{esv1.LabelManaged: esv1.LabelManagedValue}
+		return controllerutil.SetControllerReference(es, secret, scheme)
+	}
+	version := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+	if err := mutationFunc(version); err != nil {
+		t.Fatal(err)
+	}
+	name := versionedSecretName("secret", version)
+
+	cases := map[string]func(secret *v1.Secret){
+		"MissingLabel": func(secret *v1.Secret) {
+			_ = controllerutil.SetControllerReference(es, secret, scheme)
+		},
+		"OtherLabel": func(secret *v1.Secret) {
+			secret.Labels = map[string]string{esv1.LabelVersionOf: ownerLabelFor(other)}
+			_ = controllerutil.SetControllerReference(es, secret, scheme)
+		},
+		"OtherOwner": func(secret *v1.Secret) {
+			secret.Labels = map[string]string{esv1.LabelVersionOf: ownerLabelFor(es)}
+			_ = controllerutil.SetControllerReference(other, secret, scheme)
+		},
+		"NoOwner": func(secret *v1.Secret) {
+			secret.Labels = map[string]string{esv1.LabelVersionOf: ownerLabelFor(es)}
+		},
+	}
+	for caseName, mutate := range cases {
+		t.Run(caseName, func(t *testing.T) {
+			existing := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Data:       map[string][]byte{"foo": []byte("injected")},
+			}
+			mutate(existing)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+			r := &Reconciler{Client: fakeClient, APIReader: fakeClient, SecretClient: fakeClient, recorder: record.NewFakeRecorder(10)}
+			es.Status = esv1.ExternalSecretStatus{}
+
+			err := r.syncVersionedSecret(context.Background(), mutationFunc, es, "secret")
+			if err == nil || !strings.Contains(err.Error(), "is not a version of this ExternalSecret") {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if es.Status.CurrentSecretName != "" {
+				t.Errorf("CurrentSecretName = %q, want it to be unset", es.Status.CurrentSecretName)
+			}
+		})
+	}
+}
+
+func TestDeleteAllVersions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default", UID: "es"},
+		Spec: esv1.ExternalSecretSpec{
+			Target: esv1.ExternalSecretTarget{
+				Name:           "secret",
+				CreationPolicy: esv1.CreatePolicyOwner,
+				DeletionPolicy: esv1.DeletionPolicyDelete,
+				Versioning:     &esv1.ExternalSecretTargetVersioning{Retain: ptr.To[int32](1)},
+			},
+		},
+		Status: esv1.ExternalSecretStatus{CurrentSecretName: "secret-current"},
+	}
+	version := func(name string) *v1.Secret {
+		return &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{esv1.LabelVersionOf: ownerLabelFor(es)},
+		}}
+	}
+	other := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		version("secret-current"), version("secret-previous"), version("secret-old"), other,
+	).Build()
+	r := &Reconciler{Client: fakeClient, SecretClient: fakeClient, recorder: record.NewFakeRecorder(10)}
+
+	if err := r.cleanupManagedSecrets(context.Background(), logr.Discard(), es); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{"secret-current": false, "secret-previous": false, "secret-old": false, "other": true} {
+		err := fakeClient.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "default"}, &v1.Secret{})
+		if exists := err == nil; exists != want {
+			t.Errorf("secret %s exists = %t, want %t (err %v)", name, exists, want, err)
+		}
+	}
+}
+
+func TestVersionedSecretName(t *testing.T) {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{esv1.AnnotationDataHash: "hash"}}}
+	name := versionedSecretName(strings.Repeat("a", 300), secret)
+	if len(name) > maxSecretNameLength {
+		t.Errorf("name has %d characters, want at most %d", len(name), maxSecretNameLength)
+	}
+	if versionedSecretName("secret", secret) == versionedSecretName("secret", &v1.Secret{
+		ObjectMeta: secret.ObjectMeta,
+		Type:       v1.SecretTypeDockerConfigJson,
+	}) {
+		t.Errorf("secrets of different types must have different names")
+	}
+}
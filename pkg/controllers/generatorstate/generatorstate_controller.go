@@ -24,6 +24,7 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,7 +48,13 @@ type Reconciler struct {
 	recorder   record.EventRecorder
 }
 
-const generatorStateFinalizer = "generatorstate.externalsecrets.io/finalizer"
+const (
+	generatorStateFinalizer = "generatorstate.externalsecrets.io/finalizer"
+
+	reasonCleanupFailed = "CleanupFailed"
+	reasonDeleteFailed  = "DeleteFailed"
+	reasonForceCleanup  = "ForceCleanup"
+)
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -64,6 +71,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
+	// persist the conditions, so failing cleanups are visible on the GeneratorState.
+	currentStatus := *generatorState.Status.DeepCopy()
+	defer func() {
+		if equality.Semantic.DeepEqual(currentStatus, generatorState.Status) {
+			return
+		}
+		if updateErr := r.Client.Update(ctx, generatorState); updateErr != nil && !apierrors.IsNotFound(updateErr) && !apierrors.IsConflict(updateErr) {
+			r.Log.Error(updateErr, "could not update GeneratorState status", "GeneratorState", req.NamespacedName)
+		}
+	}()
+
 	requeue, err := r.handleFinalizer(ctx, generatorState)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -72,6 +90,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if forceCleanup(generatorState) {
+		if generatorState.DeletionTimestamp != nil {
+			return ctrl.Result{}, nil
+		}
+		if err := r.Client.Delete(ctx, generatorState, &client.DeleteOptions{}); err != nil {
+			r.markAsFailed(reasonDeleteFailed, "could not delete GeneratorState", err, generatorState)
+			return ctrl.Result{}, fmt.Errorf("could not delete GeneratorState: %w", err)
+		}
+		r.recorder.Event(generatorState, v1.EventTypeNormal, reasonForceCleanup, "force cleanup requested")
+		return ctrl.Result{}, nil
+	}
+
 	if generatorState.Spec.GarbageCollectionDeadline != nil {
 		if generatorState.Spec.GarbageCollectionDeadline.Time.Before(time.Now()) {
 			if generatorState.DeletionTimestamp != nil {
@@ -79,7 +109,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 			}
 
 			if err := r.Client.Delete(ctx, generatorState, &client.DeleteOptions{}); err != nil {
-				r.markAsFailed("could not delete GeneratorState", err, generatorState)
+				r.markAsFailed(reasonDeleteFailed, "could not delete GeneratorState", err, generatorState)
 				return ctrl.Result{}, fmt.Errorf("could not delete GeneratorState: %w", err)
 			}
 			r.markSuccess("Reached gc deadline", generatorState)
@@ -103,15 +133,12 @@ func (r *Reconciler) handleFinalizer(ctx context.Context, generatorState *genv1a
 			return true, nil
 		}
 	} else if controllerutil.ContainsFinalizer(generatorState, generatorStateFinalizer) {
-		gen, err := r.getGenerator(generatorState.Spec.Resource.Raw)
-		if err != nil {
-			r.markAsFailed("could not get generator", err, generatorState)
-			return false, fmt.Errorf("could not get generator: %w", err)
-		}
-
-		if err := gen.Cleanup(ctx, generatorState.Spec.Resource, generatorState.Spec.State, r.Client, generatorState.Namespace); err != nil {
-			r.markAsFailed("could not cleanup generator state", err, generatorState)
-			return false, fmt.Errorf("could not cleanup generator state: %w", err)
+		if err := r.cleanup(ctx, generatorState); err != nil {
+			if !forceCleanup(generatorState) {
+				return false, err
+			}
+			// the user accepted that the generated values may be left behind.
+			r.recorder.Eventf(generatorState, v1.EventTypeWarning, reasonForceCleanup, "removing finalizer after failed cleanup: %v", err)
 		}
 
 		controllerutil.RemoveFinalizer(generatorState, generatorStateFinalizer)
@@ -122,6 +149,25 @@ func (r *Reconciler) handleFinalizer(ctx context.Context, generatorState *genv1a
 	return false, nil
 }
 
+func (r *Reconciler) cleanup(ctx context.Context, generatorState *genv1alpha1.GeneratorState) error {
+	gen, err := r.getGenerator(generatorState.Spec.Resource.Raw)
+	if err != nil {
+		r.markAsFailed(reasonCleanupFailed, "could not get generator", err, generatorState)
+		return fmt.Errorf("could not get generator: %w", err)
+	}
+
+	if err := gen.Cleanup(ctx, generatorState.Spec.Resource, generatorState.Spec.State, r.Client, generatorState.Namespace); err != nil {
+		r.markAsFailed(reasonCleanupFailed, "could not cleanup generator state", err, generatorState)
+		return fmt.Errorf("could not cleanup generator state: %w", err)
+	}
+	return nil
+}
+
+// forceCleanup returns true if the user requested to clean up the state regardless of errors.
+func forceCleanup(generatorState *genv1alpha1.GeneratorState) bool {
+	return generatorState.Annotations[genv1alpha1.GeneratorStateAnnotationForceCleanup] == "true"
+}
+
 func (r *Reconciler) getGenerator(resource []byte) (genv1alpha1.Generator, error) {
 	us := &unstructured.Unstructured{}
 	if err := us.UnmarshalJSON(resource); err != nil {
@@ -134,9 +180,11 @@ func (r *Reconciler) getGenerator(resource []byte) (genv1alpha1.Generator, error
 	return gen, nil
 }
 
-func (r *Reconciler) markAsFailed(msg string, err error, gs *genv1alpha1.GeneratorState) {
+// markAsFailed sets the Ready condition to false and emits a warning event with the given reason.
+func (r *Reconciler) markAsFailed(reason, msg string, err error, gs *genv1alpha1.GeneratorState) {
 	conditionSynced := NewGeneratorStateCondition(genv1alpha1.GeneratorStateReady, v1.ConditionFalse, genv1alpha1.ConditionReasonError, fmt.Sprintf("%s: %v", msg, err))
 	SetGeneratorStateCondition(gs, *conditionSynced)
+	r.recorder.Event(gs, v1.EventTypeWarning, reason, conditionSynced.Message)
 }
 
 func (r *Reconciler) markSuccess(msg string, gs *genv1alpha1.GeneratorState) {
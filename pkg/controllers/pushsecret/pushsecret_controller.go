@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -44,9 +45,11 @@ import (
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret/psmetrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+	"github.com/external-secrets/external-secrets/pkg/controllers/sharding"
 	"github.com/external-secrets/external-secrets/pkg/controllers/util"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
 	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/generator/ratelimit"
 	"github.com/external-secrets/external-secrets/pkg/generator/statemanager"
 	"github.com/external-secrets/external-secrets/pkg/provider/util/locks"
 
@@ -77,6 +80,7 @@ type Reconciler struct {
 	RestConfig      *rest.Config
 	RequeueInterval time.Duration
 	ControllerClass string
+	Shard           *sharding.Shard
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -113,10 +117,14 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return err
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
-		For(&esapi.PushSecret{}).
-		Complete(r)
+		For(&esapi.PushSecret{}, builder.WithPredicates(r.Shard.Predicate()))
+	if r.Shard != nil {
+		// reconcile the PushSecrets this replica takes over when replicas join or leave the shard group
+		b = b.WatchesRawSource(r.Shard.Source(mgr.GetClient(), &esapi.PushSecretList{}))
+	}
+	return b.Complete(r)
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -150,6 +158,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, fmt.Errorf("get resource: %w", err)
 	}
 
+	// the PushSecret is reconciled by another replica of the shard group
+	if !r.Shard.Owns(&ps) {
+		log.V(1).Info("skipping PushSecret, owned by another shard")
+		return ctrl.Result{}, nil
+	}
+
 	refreshInt := r.RequeueInterval
 	if ps.Spec.RefreshInterval != nil {
 		refreshInt = ps.Spec.RefreshInterval.Duration
@@ -207,6 +221,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{RequeueAfter: refreshInt}, nil
 	}
 
+	if err := r.setGeneratorStates(ctx, &ps); err != nil {
+		log.Error(err, "unable to list generator states")
+	}
+
 	secrets, err := r.resolveSecrets(ctx, &ps)
 	if err != nil {
 		r.markAsFailed(errFailedGetSecret, &ps, nil)
@@ -502,6 +520,19 @@ func (r *Reconciler) resolveSecrets(ctx context.Context, ps *esapi.PushSecret) (
 	return nil, errors.New("no secret selector provided")
 }
 
+// setGeneratorStates lists the generator states that are owned by the PushSecret in its status.
+func (r *Reconciler) setGeneratorStates(ctx context.Context, ps *esapi.PushSecret) error {
+	states, err := statemanager.New(ctx, r.Client, r.Scheme, ps.Namespace, ps).GetAllStates(defaultGeneratorStateKey)
+	if err != nil {
+		return err
+	}
+	ps.Status.GeneratorStates = nil
+	if len(states) > 0 {
+		ps.Status.GeneratorStates = statemanager.Summarize(defaultGeneratorStateKey, states)
+	}
+	return nil
+}
+
 func (r *Reconciler) resolveSecretFromGenerator(ctx context.Context, namespace string, generatorRef *esv1.GeneratorRef, generatorState *statemanager.Manager) (*v1.Secret, error) {
 	gen, genResource, err := resolvers.GeneratorRef(ctx, r.Client, r.Scheme, namespace, generatorRef)
 	if err != nil {
@@ -514,15 +545,37 @@ func (r *Reconciler) resolveSecretFromGenerator(ctx context.Context, namespace s
 			return nil, fmt.Errorf("unable to get latest state: %w", err)
 		}
 	}
-	secretMap, newState, err := gen.Generate(ctx, genResource, r.Client, namespace)
-	if err != nil {
-		return nil, fmt.Errorf("unable to generate: %w", err)
+	policy := statemanager.NewRegenerationPolicy(generatorRef)
+	var secretMap map[string][]byte
+	if prevState != nil {
+		// re-use the previously generated values if they are still young enough
+		secretMap, err = generatorState.ReuseLatest(ctx, prevState, genResource, policy)
+		if err != nil {
+			return nil, fmt.Errorf("unable to re-use generated values: %w", err)
+		}
 	}
-	if prevState != nil && generatorState != nil {
-		generatorState.EnqueueMoveStateToGC(defaultGeneratorStateKey)
+	if secretMap == nil && prevState != nil {
+		// re-use the previously generated values if the generator was able to renew them
+		secretMap, err = generatorState.RenewLatest(ctx, prevState, genResource, gen)
+		if err != nil {
+			return nil, fmt.Errorf("unable to renew generated values: %w", err)
+		}
 	}
-	if generatorState != nil {
-		generatorState.EnqueueSetLatest(ctx, defaultGeneratorStateKey, namespace, genResource, gen, newState)
+	if secretMap == nil {
+		if err := ratelimit.AllowResource(genResource); err != nil {
+			return nil, fmt.Errorf("unable to generate: %w", err)
+		}
+		var newState genv1alpha1.GeneratorProviderState
+		secretMap, newState, err = gen.Generate(ctx, genResource, r.Client, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate: %w", err)
+		}
+		if prevState != nil {
+			generatorState.EnqueueMoveStateToGC(defaultGeneratorStateKey, statemanager.NewRetention(generatorRef))
+		}
+		if generatorState != nil {
+			generatorState.EnqueueSetLatest(ctx, defaultGeneratorStateKey, namespace, genResource, gen, newState, secretMap, policy.Enabled())
+		}
 	}
 	return &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,206 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiver
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	snsTypeNotification             = "Notification"
+	snsTypeSubscriptionConfirmation = "SubscriptionConfirmation"
+
+	eventGridValidationEvent = "Microsoft.EventGrid.SubscriptionValidationEvent"
+)
+
+var (
+	errUnknownPayload = errors.New("unknown payload format")
+	errNoKeys         = errors.New("payload does not reference any remote key")
+
+	// the name of a secret in an AWS Secrets Manager ARN has a random 6 character suffix.
+	awsSecretARN = regexp.MustCompile(`^arn:aws[a-z-]*:secretsmanager:[^:]*:[^:]*:secret:(.+)-[a-zA-Z0-9]{6}$`)
+)
+
+// notification is a parsed notification of a remote key change.
+type notification struct {
+	// Keys are the remote keys that changed.
+	// A change may be reported with different names of the same secret, e.g. ARN and name.
+	Keys []string
+	// SubscribeURL is set if an AWS SNS subscription must be confirmed.
+	SubscribeURL string
+	// ValidationCode is set if an Azure Event Grid subscription must be validated.
+	ValidationCode string
+}
+
+// genericPayload is the payload for systems which can be configured to send arbitrary JSON.
+type genericPayload struct {
+	Keys []string `json:"keys"`
+}
+
+// snsPayload is an AWS SNS HTTP(S) delivery.
+type snsPayload struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// eventBridgePayload is an AWS EventBridge event, e.g. a CloudTrail event of AWS Secrets Manager.
+type eventBridgePayload struct {
+	Source    string   `json:"source"`
+	Resources []string `json:"resources"`
+	Detail    struct {
+		RequestParameters struct {
+			SecretID string `json:"secretId"`
+			Name     string `json:"name"`
+		} `json:"requestParameters"`
+		AdditionalEventData struct {
+			SecretID string `json:"SecretId"`
+		} `json:"additionalEventData"`
+	} `json:"detail"`
+}
+
+// pubSubPayload is a GCP Pub/Sub push delivery, e.g. a Secret Manager notification.
+type pubSubPayload struct {
+	Message *struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+}
+
+// eventGridEvent is an Azure Event Grid event, e.g. a Key Vault event.
+type eventGridEvent struct {
+	EventType string `json:"eventType"`
+	Subject   string `json:"subject"`
+	Data      struct {
+		ObjectName     string `json:"ObjectName"`
+		ValidationCode string `json:"validationCode"`
+	} `json:"data"`
+}
+
+// parse detects the format of the payload and returns the notification.
+func parse(body []byte) (*notification, error) {
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "[") {
+		return parseEventGrid(body)
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return nil, err
+	}
+	switch {
+	case probe["keys"] != nil:
+		var payload genericPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		return newNotification(payload.Keys)
+	case probe["Type"] != nil && probe["TopicArn"] != nil:
+		return parseSNS(body)
+	case probe["detail"] != nil && probe["source"] != nil:
+		return parseEventBridge(body)
+	case probe["message"] != nil && probe["subscription"] != nil:
+		return parsePubSub(body)
+	}
+	return nil, errUnknownPayload
+}
+
+func parseSNS(body []byte) (*notification, error) {
+	var payload snsPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	switch payload.Type {
+	case snsTypeSubscriptionConfirmation:
+		return &notification{SubscribeURL: payload.SubscribeURL}, nil
+	case snsTypeNotification:
+		return parseEventBridge([]byte(payload.Message))
+	}
+	return nil, errUnknownPayload
+}
+
+func parseEventBridge(body []byte) (*notification, error) {
+	var payload eventBridgePayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	keys := []string{
+		payload.Detail.RequestParameters.SecretID,
+		payload.Detail.RequestParameters.Name,
+		payload.Detail.AdditionalEventData.SecretID,
+	}
+	keys = append(keys, payload.Resources...)
+	for _, key := range keys {
+		// secrets can be referenced by ARN or by name.
+		if match := awsSecretARN.FindStringSubmatch(key); match != nil {
+			keys = append(keys, match[1])
+		}
+	}
+	return newNotification(keys)
+}
+
+func parsePubSub(body []byte) (*notification, error) {
+	var payload pubSubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Message == nil {
+		return nil, errUnknownPayload
+	}
+	// secretId is the full resource name, e.g. projects/my-project/secrets/my-secret.
+	secretID := payload.Message.Attributes["secretId"]
+	keys := []string{secretID}
+	if i := strings.LastIndex(secretID, "/"); i >= 0 {
+		keys = append(keys, secretID[i+1:])
+	}
+	return newNotification(keys)
+}
+
+func parseEventGrid(body []byte) (*notification, error) {
+	var events []eventGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, event := range events {
+		if event.EventType == eventGridValidationEvent {
+			return &notification{ValidationCode: event.Data.ValidationCode}, nil
+		}
+		keys = append(keys, event.Data.ObjectName, event.Subject)
+	}
+	return newNotification(keys)
+}
+
+// newNotification returns a notification for the non-empty and unique keys.
+func newNotification(keys []string) (*notification, error) {
+	n := &notification{}
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		n.Keys = append(n.Keys, key)
+	}
+	if len(n.Keys) == 0 {
+		return nil, errNoKeys
+	}
+	return n, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		body    string
+		want    *notification
+		wantErr error
+	}{
+		"Generic": {
+			body: `{"keys":["db-password","db-password",""]}`,
+			want: &notification{Keys: []string{"db-password"}},
+		},
+		"GenericWithoutKeys": {
+			body:    `{"keys":[]}`,
+			wantErr: errNoKeys,
+		},
+		"EventBridge": {
+			body: `{"source":"aws.secretsmanager","detail-type":"AWS API Call via CloudTrail","resources":[],
+				"detail":{"eventName":"PutSecretValue","requestParameters":{"secretId":"arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-AbC123"}}}`,
+			want: &notification{Keys: []string{"arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-AbC123", "prod/db"}},
+		},
+		"EventBridgeRotation": {
+			body: `{"source":"aws.secretsmanager","detail":{"eventName":"RotationSucceeded","additionalEventData":{"SecretId":"prod/db"}}}`,
+			want: &notification{Keys: []string{"prod/db"}},
+		},
+		"SNSNotification": {
+			body: `{"Type":"Notification","TopicArn":"arn:aws:sns:eu-west-1:123456789012:secrets",
+				"Message":"{\"source\":\"aws.secretsmanager\",\"detail\":{\"requestParameters\":{\"secretId\":\"prod/db\"}}}"}`,
+			want: &notification{Keys: []string{"prod/db"}},
+		},
+		"SNSSubscriptionConfirmation": {
+			body: `{"Type":"SubscriptionConfirmation","TopicArn":"arn:aws:sns:eu-west-1:123456789012:secrets",
+				"SubscribeURL":"https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription"}`,
+			want: &notification{SubscribeURL: "https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription"},
+		},
+		"PubSub": {
+			body: `{"subscription":"projects/p/subscriptions/s","message":{"data":"e30=",
+				"attributes":{"eventType":"SECRET_VERSION_ADD","secretId":"projects/p/secrets/db-password"}}}`,
+			want: &notification{Keys: []string{"projects/p/secrets/db-password", "db-password"}},
+		},
+		"EventGrid": {
+			body: `[{"eventType":"Microsoft.KeyVault.SecretNewVersionCreated","subject":"db-password",
+				"data":{"ObjectName":"db-password","VaultName":"my-vault"}}]`,
+			want: &notification{Keys: []string{"db-password"}},
+		},
+		"EventGridValidation": {
+			body: `[{"eventType":"Microsoft.EventGrid.SubscriptionValidationEvent","data":{"validationCode":"abc"}}]`,
+			want: &notification{ValidationCode: "abc"},
+		},
+		"Unknown": {
+			body:    `{"foo":"bar"}`,
+			wantErr: errUnknownPayload,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parse([]byte(tc.body))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected notification: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package receiver implements an HTTP endpoint which refreshes ExternalSecrets
+// when an external system notifies about a change of a remote key.
+package receiver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+const (
+	// SecretKeyToken is the key of the token in the receiver Secret.
+	// The token can be sent as bearer token or basic auth password.
+	SecretKeyToken = "token"
+	// SecretKeyHMAC is the key of the HMAC key in the receiver Secret.
+	// The HMAC-SHA256 of the body is expected in the signature header as sha256=<hex>.
+	SecretKeyHMAC = "hmac-key"
+
+	// HeaderSignature is the header containing the HMAC-SHA256 signature of the body.
+	HeaderSignature = "X-Signature-256"
+
+	indexESRemoteKeyField = ".spec.remoteKeys"
+
+	// wildcardKey is indexed for ExternalSecrets which find secrets of a store,
+	// they are refreshed on every change of a remote key in that store.
+	wildcardKey = "*"
+
+	maxBodySize     = 1 << 20
+	shutdownTimeout = 10 * time.Second
+
+	errUnauthorized = "unauthorized"
+	errReadSecret   = "unable to read receiver secret: %w"
+	errNoCredential = "receiver secret %s must contain %q or %q"
+)
+
+// snsSubscribeURL matches the subscription confirmation URLs of AWS SNS.
+var snsSubscribeURL = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// Receiver is an HTTP server which refreshes the ExternalSecrets that reference a changed remote key.
+type Receiver struct {
+	// Client is used to find and refresh the ExternalSecrets.
+	Client client.Client
+	// Reader is used to read the receiver Secret. If it is not set, SetupWithManager
+	// sets it to a cache which only watches the receiver Secret.
+	Reader client.Reader
+	Log    logr.Logger
+	// Addr is the address the receiver listens on.
+	Addr string
+	// Secret contains the credentials callers must authenticate with.
+	Secret types.NamespacedName
+
+	httpClient *http.Client
+}
+
+// SetupWithManager indexes ExternalSecrets by their remote keys and adds the receiver to the manager.
+func (r *Receiver) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Reader == nil {
+		// secrets are not cached by the manager, the receiver secret is watched,
+		// so requests are authenticated without reading it from the API server.
+		secretCache, err := cache.New(mgr.GetConfig(), cache.Options{
+			Scheme: mgr.GetScheme(),
+			Mapper: mgr.GetRESTMapper(),
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Secret{}: {
+					Namespaces: map[string]cache.Config{r.Secret.Namespace: {}},
+					Field:      fields.OneTermEqualSelector("metadata.name", r.Secret.Name),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := mgr.Add(secretCache); err != nil {
+			return err
+		}
+		r.Reader = secretCache
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &esv1.ExternalSecret{}, indexESRemoteKeyField, func(obj client.Object) []string {
+		return remoteKeys(obj.(*esv1.ExternalSecret))
+	}); err != nil {
+		return err
+	}
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection returns false, the receiver is served by all replicas.
+// ExternalSecrets are refreshed by setting status.refreshRequestedAt, which is picked up by the replica reconciling them.
+func (r *Receiver) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the receiver until the context is done.
+func (r *Receiver) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              r.Addr,
+		Handler:           r.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	r.Log.Info("starting refresh receiver", "addr", r.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler of the receiver.
+// Notifications are posted to the store the remote keys belong to:
+//   - /refresh/namespaces/{namespace}/secretstores/{name}
+//   - /refresh/clustersecretstores/{name}
+func (r *Receiver) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /refresh/namespaces/{namespace}/secretstores/{name}", func(w http.ResponseWriter, req *http.Request) {
+		r.handle(w, req, esv1.SecretStoreKind, req.PathValue("namespace"), req.PathValue("name"))
+	})
+	mux.HandleFunc("POST /refresh/clustersecretstores/{name}", func(w http.ResponseWriter, req *http.Request) {
+		r.handle(w, req, esv1.ClusterSecretStoreKind, "", req.PathValue("name"))
+	})
+	return mux
+}
+
+func (r *Receiver) handle(w http.ResponseWriter, req *http.Request, kind, namespace, name string) {
+	ctx := req.Context()
+	log := r.Log.WithValues("kind", kind, "namespace", namespace, "name", name)
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := r.authenticate(ctx, req, body); err != nil {
+		log.V(1).Info("rejecting notification", "reason", err.Error())
+		http.Error(w, errUnauthorized, http.StatusUnauthorized)
+		return
+	}
+	n, err := parse(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case n.ValidationCode != "":
+		writeJSON(w, http.StatusOK, map[string]string{"validationResponse": n.ValidationCode})
+		return
+	case n.SubscribeURL != "":
+		if err := r.confirmSubscription(ctx, n.SubscribeURL); err != nil {
+			log.Error(err, "unable to confirm SNS subscription")
+			http.Error(w, "unable to confirm subscription", http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	refreshed, err := r.refresh(ctx, kind, namespace, name, n.Keys)
+	if err != nil {
+		log.Error(err, "unable to refresh ExternalSecrets", "keys", n.Keys)
+		http.Error(w, "unable to refresh ExternalSecrets", http.StatusInternalServerError)
+		return
+	}
+	log.V(1).Info("refreshed ExternalSecrets", "keys", n.Keys, "count", len(refreshed))
+	writeJSON(w, http.StatusAccepted, map[string][]string{"refreshed": refreshed})
+}
+
+// authenticate checks the token or the HMAC signature of the request.
+func (r *Receiver) authenticate(ctx context.Context, req *http.Request, body []byte) error {
+	var secret corev1.Secret
+	if err := r.Reader.Get(ctx, r.Secret, &secret); err != nil {
+		return fmt.Errorf(errReadSecret, err)
+	}
+	token := secret.Data[SecretKeyToken]
+	hmacKey := secret.Data[SecretKeyHMAC]
+	if len(token) == 0 && len(hmacKey) == 0 {
+		return fmt.Errorf(errNoCredential, r.Secret, SecretKeyToken, SecretKeyHMAC)
+	}
+
+	if len(hmacKey) > 0 {
+		if signature, ok := strings.CutPrefix(req.Header.Get(HeaderSignature), "sha256="); ok {
+			mac := hmac.New(sha256.New, hmacKey)
+			mac.Write(body)
+			expected := hex.EncodeToString(mac.Sum(nil))
+			if hmac.Equal([]byte(signature), []byte(expected)) {
+				return nil
+			}
+		}
+	}
+	if len(token) > 0 {
+		for _, candidate := range requestTokens(req) {
+			if subtle.ConstantTimeCompare([]byte(candidate), token) == 1 {
+				return nil
+			}
+		}
+	}
+	return errors.New(errUnauthorized)
+}
+
+// requestTokens returns the tokens sent with the request.
+// Not all systems support custom headers, e.g. AWS SNS only supports basic auth.
+// Tokens are not accepted in the query, as URLs end up in access logs.
+func requestTokens(req *http.Request) []string {
+	var tokens []string
+	if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok {
+		tokens = append(tokens, token)
+	}
+	if _, password, ok := req.BasicAuth(); ok {
+		tokens = append(tokens, password)
+	}
+	return tokens
+}
+
+// confirmSubscription confirms an AWS SNS subscription.
+func (r *Receiver) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	u, err := url.Parse(subscribeURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || !snsSubscribeURL.MatchString(u.Hostname()) {
+		return fmt.Errorf("unexpected subscribe url host %q", u.Host)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// refresh requests a refresh of all ExternalSecrets which reference one of the keys of the store.
+// The request is recorded in the status, so the spec and metadata of the ExternalSecrets are left as-is.
+func (r *Receiver) refresh(ctx context.Context, kind, namespace, name string, keys []string) ([]string, error) {
+	candidates := make(map[types.NamespacedName]esv1.ExternalSecret)
+	for _, key := range slices.Concat(keys, []string{wildcardKey}) {
+		var list esv1.ExternalSecretList
+		if err := r.Client.List(ctx, &list, &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(indexESRemoteKeyField, remoteKey(kind, name, key)),
+			Namespace:     namespace,
+		}); err != nil {
+			return nil, err
+		}
+		for _, es := range list.Items {
+			candidates[types.NamespacedName{Namespace: es.Namespace, Name: es.Name}] = es
+		}
+	}
+
+	var refreshed []string
+	for nn, es := range candidates {
+		if !refreshable(&es) {
+			continue
+		}
+		patch := client.MergeFrom(es.DeepCopy())
+		now := metav1.Now()
+		es.Status.RefreshRequestedAt = &now
+		if err := r.Client.Status().Patch(ctx, &es, patch); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		refreshed = append(refreshed, nn.String())
+	}
+	slices.Sort(refreshed)
+	return refreshed, nil
+}
+
+// refreshable returns true if the ExternalSecret is refreshed periodically.
+// ExternalSecrets with another refresh policy are only refreshed when they change.
+func refreshable(es *esv1.ExternalSecret) bool {
+	switch es.Spec.RefreshPolicy {
+	case esv1.RefreshPolicyPeriodic, "":
+		return es.Spec.RefreshInterval == nil || es.Spec.RefreshInterval.Duration > 0
+	default:
+		return false
+	}
+}
+
+// remoteKey is the value of the remote key index, e.g. "SecretStore/my-store/db-password".
+func remoteKey(kind, store, key string) string {
+	return kind + "/" + store + "/" + key
+}
+
+// remoteKeys returns the remote keys the ExternalSecret reads, together with their stores.
+func remoteKeys(es *esv1.ExternalSecret) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	add := func(ref *esv1.SecretStoreRef, key string) {
+		if ref == nil || ref.Name == "" {
+			ref = &es.Spec.SecretStoreRef
+		}
+		kind := ref.Kind
+		if kind == "" {
+			kind = esv1.SecretStoreKind
+		}
+		value := remoteKey(kind, ref.Name, key)
+		if !seen[value] {
+			seen[value] = true
+			keys = append(keys, value)
+		}
+	}
+	for _, data := range es.Spec.Data {
+		var ref *esv1.SecretStoreRef
+		if data.SourceRef != nil {
+			ref = &data.SourceRef.SecretStoreRef
+		}
+		add(ref, data.RemoteRef.Key)
+	}
+	for _, data := range es.Spec.DataFrom {
+		var ref *esv1.SecretStoreRef
+		if data.SourceRef != nil {
+			if data.SourceRef.GeneratorRef != nil {
+				continue
+			}
+			ref = data.SourceRef.SecretStoreRef
+		}
+		switch {
+		case data.Extract != nil:
+			add(ref, data.Extract.Key)
+		case data.Find != nil:
+			add(ref, wildcardKey)
+		}
+	}
+	return keys
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
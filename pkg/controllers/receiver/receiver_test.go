@@ -0,0 +1,215 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func testExternalSecret(name string, mutate func(es *esv1.ExternalSecret)) *esv1.ExternalSecret {
+	es := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: esv1.ExternalSecretSpec{
+			RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			SecretStoreRef:  esv1.SecretStoreRef{Name: "store"},
+		},
+	}
+	mutate(es)
+	return es
+}
+
+func newTestReceiver(t *testing.T, objs ...client.Object) (*Receiver, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "receiver", Namespace: "es"},
+		Data: map[string][]byte{
+			SecretKeyToken: []byte("token"),
+			SecretKeyHMAC:  []byte("hmac"),
+		},
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objs, secret)...).
+		WithStatusSubresource(&esv1.ExternalSecret{}).
+		WithIndex(&esv1.ExternalSecret{}, indexESRemoteKeyField, func(obj client.Object) []string {
+			return remoteKeys(obj.(*esv1.ExternalSecret))
+		}).
+		Build()
+	return &Receiver{
+		Client: kube,
+		Reader: kube,
+		Log:    logr.Discard(),
+		Secret: types.NamespacedName{Namespace: "es", Name: "receiver"},
+	}, kube
+}
+
+func TestRemoteKeys(t *testing.T) {
+	es := testExternalSecret("es", func(es *esv1.ExternalSecret) {
+		es.Spec.Data = []esv1.ExternalSecretData{
+			{RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}},
+			{RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}},
+			{
+				RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "api"},
+				SourceRef: &esv1.StoreSourceRef{SecretStoreRef: esv1.SecretStoreRef{Name: "global", Kind: esv1.ClusterSecretStoreKind}},
+			},
+		}
+		es.Spec.DataFrom = []esv1.ExternalSecretDataFromRemoteRef{
+			{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "config"}},
+			{Find: &esv1.ExternalSecretFind{Tags: map[string]string{"app": "foo"}}},
+			{SourceRef: &esv1.StoreGeneratorSourceRef{GeneratorRef: &esv1.GeneratorRef{Kind: "Password", Name: "pw"}}},
+		}
+	})
+	want := []string{"SecretStore/store/db", "ClusterSecretStore/global/api", "SecretStore/store/config", "SecretStore/store/*"}
+	if diff := cmp.Diff(want, remoteKeys(es)); diff != "" {
+		t.Errorf("unexpected remote keys: -want, +got:\n%s", diff)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	db := testExternalSecret("db", func(es *esv1.ExternalSecret) {
+		es.Spec.Data = []esv1.ExternalSecretData{{RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}}}
+	})
+	find := testExternalSecret("find", func(es *esv1.ExternalSecret) {
+		es.Spec.DataFrom = []esv1.ExternalSecretDataFromRemoteRef{{Find: &esv1.ExternalSecretFind{Tags: map[string]string{"app": "foo"}}}}
+	})
+	other := testExternalSecret("other", func(es *esv1.ExternalSecret) {
+		es.Spec.Data = []esv1.ExternalSecretData{{RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "other"}}}
+	})
+	createdOnce := testExternalSecret("created-once", func(es *esv1.ExternalSecret) {
+		es.Spec.RefreshPolicy = esv1.RefreshPolicyCreatedOnce
+		es.Spec.Data = []esv1.ExternalSecretData{{RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "db"}}}
+	})
+	body := `{"keys":["db"]}`
+	mac := hmac.New(sha256.New, []byte("hmac"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	cases := map[string]struct {
+		path       string
+		header     http.Header
+		wantStatus int
+		wantSynced []string
+	}{
+		"BearerToken": {
+			path:       "/refresh/namespaces/default/secretstores/store",
+			header:     http.Header{"Authorization": []string{"Bearer token"}},
+			wantStatus: http.StatusAccepted,
+			wantSynced: []string{"db", "find"},
+		},
+		"QueryToken": {
+			path:       "/refresh/namespaces/default/secretstores/store?token=token",
+			wantStatus: http.StatusUnauthorized,
+		},
+		"Signature": {
+			path:       "/refresh/namespaces/default/secretstores/store",
+			header:     http.Header{HeaderSignature: []string{signature}},
+			wantStatus: http.StatusAccepted,
+			wantSynced: []string{"db", "find"},
+		},
+		"OtherStore": {
+			path:       "/refresh/clustersecretstores/store",
+			header:     http.Header{"Authorization": []string{"Bearer token"}},
+			wantStatus: http.StatusAccepted,
+		},
+		"WrongToken": {
+			path:       "/refresh/namespaces/default/secretstores/store",
+			header:     http.Header{"Authorization": []string{"Bearer wrong"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"WrongSignature": {
+			path:       "/refresh/namespaces/default/secretstores/store",
+			header:     http.Header{HeaderSignature: []string{"sha256=abc"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, kube := newTestReceiver(t, db.DeepCopy(), find.DeepCopy(), other.DeepCopy(), createdOnce.DeepCopy())
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(body))
+			for key, values := range tc.header {
+				req.Header[key] = values
+			}
+			rec := httptest.NewRecorder()
+			r.Handler().ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+
+			var list esv1.ExternalSecretList
+			if err := kube.List(context.Background(), &list); err != nil {
+				t.Fatalf("unable to list ExternalSecrets: %v", err)
+			}
+			var synced []string
+			for _, es := range list.Items {
+				if es.Annotations != nil {
+					t.Errorf("unexpected annotations on %s: %v", es.Name, es.Annotations)
+				}
+				if es.Status.RefreshRequestedAt != nil {
+					synced = append(synced, es.Name)
+				}
+			}
+			if diff := cmp.Diff(tc.wantSynced, synced); diff != "" {
+				t.Errorf("unexpected refreshed ExternalSecrets: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandlerEventGridValidation(t *testing.T) {
+	r, _ := newTestReceiver(t)
+	req := httptest.NewRequest(http.MethodPost, "/refresh/clustersecretstores/vault",
+		strings.NewReader(`[{"eventType":"Microsoft.EventGrid.SubscriptionValidationEvent","data":{"validationCode":"abc"}}]`))
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if diff := cmp.Diff(`{"validationResponse":"abc"}`, strings.TrimSpace(rec.Body.String())); diff != "" {
+		t.Errorf("unexpected response: -want, +got:\n%s", diff)
+	}
+}
+
+func TestConfirmSubscriptionRejectsForeignHosts(t *testing.T) {
+	r, _ := newTestReceiver(t)
+	if err := r.confirmSubscription(context.Background(), "https://example.com/?Action=ConfirmSubscription"); err == nil {
+		t.Errorf("expected an error for a foreign subscribe url")
+	}
+}
@@ -0,0 +1,365 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/feature"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+var (
+	circuitBreakerThreshold  int
+	circuitBreakerTimeout    time.Duration
+	circuitBreakerMaxTimeout time.Duration
+
+	breakersMu sync.Mutex
+	breakers   = map[storeRef]*circuitBreaker{}
+
+	// replicaName identifies the controller replica in the status, as each replica has its own circuit breakers.
+	replicaName = sync.OnceValue(func() string {
+		hostname, _ := os.Hostname()
+		return hostname
+	})
+
+	// circuitBreakerEvents enqueue a store in its controller when its circuit breaker changes state,
+	// so the state is written to the status of the store.
+	circuitBreakerEvents = map[string]chan event.GenericEvent{
+		esv1.SecretStoreKind:        make(chan event.GenericEvent, 1024),
+		esv1.ClusterSecretStoreKind: make(chan event.GenericEvent, 1024),
+	}
+)
+
+func init() {
+	fs := pflag.NewFlagSet("store-circuit-breaker", pflag.ExitOnError)
+	fs.IntVar(&circuitBreakerThreshold, "store-circuit-breaker-threshold", 0, "The number of consecutive failed requests towards the provider of a (Cluster)SecretStore "+
+		"after which requests of the replica are rejected until the provider recovers. The circuit breaker is disabled if 0.")
+	fs.DurationVar(&circuitBreakerTimeout, "store-circuit-breaker-timeout", 30*time.Second, "The time an open circuit breaker rejects requests before it probes the provider again.")
+	fs.DurationVar(&circuitBreakerMaxTimeout, "store-circuit-breaker-max-timeout", 5*time.Minute, "The maximum time an open circuit breaker rejects requests, the timeout doubles with every failed probe.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+	})
+}
+
+// StoreUnavailableError is returned for requests towards a store whose circuit breaker is open.
+type StoreUnavailableError struct {
+	Kind       string
+	Name       string
+	RetryAfter time.Duration
+}
+
+func (e *StoreUnavailableError) Error() string {
+	return fmt.Sprintf("%s %q is unavailable after consecutive provider errors", e.Kind, e.Name)
+}
+
+// SetCircuitBreaker configures the circuit breaker of each store.
+// A threshold of zero disables the circuit breaker.
+func SetCircuitBreaker(threshold int, timeout, maxTimeout time.Duration) {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	circuitBreakerThreshold = threshold
+	circuitBreakerTimeout = timeout
+	circuitBreakerMaxTimeout = maxTimeout
+	breakers = map[storeRef]*circuitBreaker{}
+}
+
+// storeCircuitBreaker returns the circuit breaker shared by all requests of this replica towards the provider of the store
+// or nil if the circuit breaker is disabled.
+func storeCircuitBreaker(ref storeRef) *circuitBreaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	if circuitBreakerThreshold <= 0 {
+		return nil
+	}
+	breaker, ok := breakers[ref]
+	if !ok {
+		breaker = &circuitBreaker{
+			store:      ref,
+			threshold:  circuitBreakerThreshold,
+			timeout:    circuitBreakerTimeout,
+			maxTimeout: max(circuitBreakerMaxTimeout, circuitBreakerTimeout),
+			state:      esv1.CircuitBreakerClosed,
+		}
+		breaker.openTimeout = breaker.timeout
+		breakers[ref] = breaker
+	}
+	return breaker
+}
+
+// removeCircuitBreaker removes the circuit breaker of a deleted store.
+func removeCircuitBreaker(ref storeRef) {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	if _, ok := breakers[ref]; !ok {
+		return
+	}
+	delete(breakers, ref)
+	metrics.RemoveStoreCircuitBreaker(ref.kind, ref.namespace, ref.name)
+}
+
+// circuitBreakerStatus returns the status of the circuit breaker of the store
+// or nil if the circuit breaker is disabled.
+func circuitBreakerStatus(store esv1.GenericStore) *esv1.SecretStoreCircuitBreakerStatus {
+	breaker := storeCircuitBreaker(refForStore(store))
+	if breaker == nil {
+		return nil
+	}
+	return breaker.status()
+}
+
+// circuitBreakerSource enqueues the stores of the kind whose circuit breaker changed state.
+func circuitBreakerSource(kind string) source.Source {
+	return source.Channel(circuitBreakerEvents[kind], &handler.EnqueueRequestForObject{})
+}
+
+// circuitBreaker rejects the requests towards the provider of a store
+// after a number of consecutive failed requests across all ExternalSecrets.
+// Once the timeout expired it allows a single request to probe the provider,
+// which closes the circuit breaker on success or opens it again with a doubled timeout.
+type circuitBreaker struct {
+	store      storeRef
+	threshold  int
+	timeout    time.Duration
+	maxTimeout time.Duration
+
+	mu                 sync.Mutex
+	state              esv1.SecretStoreCircuitBreakerState
+	failures           int
+	openTimeout        time.Duration
+	retryTime          time.Time
+	probing            bool
+	lastTransitionTime time.Time
+	message            string
+}
+
+// allow returns a StoreUnavailableError if the request must not be sent to the provider.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case esv1.CircuitBreakerOpen:
+		if wait := time.Until(b.retryTime); wait > 0 {
+			return b.reject(wait)
+		}
+		b.transition(esv1.CircuitBreakerHalfOpen)
+		b.probing = true
+	case esv1.CircuitBreakerHalfOpen:
+		if b.probing {
+			return b.reject(b.timeout)
+		}
+		b.probing = true
+	case esv1.CircuitBreakerClosed:
+	}
+	return nil
+}
+
+// record updates the circuit breaker with the result of a request.
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		b.openTimeout = b.timeout
+		if b.state != esv1.CircuitBreakerClosed {
+			b.message = ""
+			b.transition(esv1.CircuitBreakerClosed)
+		}
+		return
+	}
+
+	b.failures++
+	b.message = err.Error()
+	switch {
+	case b.state == esv1.CircuitBreakerHalfOpen:
+		b.openTimeout = min(b.openTimeout*2, b.maxTimeout)
+		b.open()
+	case b.state == esv1.CircuitBreakerClosed && b.failures >= b.threshold:
+		b.open()
+	}
+}
+
+// cancel releases the probe of a request without a result.
+func (b *circuitBreaker) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) open() {
+	b.retryTime = time.Now().Add(b.openTimeout)
+	b.transition(esv1.CircuitBreakerOpen)
+}
+
+func (b *circuitBreaker) reject(retryAfter time.Duration) error {
+	metrics.ObserveRejectedStoreRequest(b.store.kind, b.store.namespace, b.store.name)
+	return &StoreUnavailableError{
+		Kind:       b.store.kind,
+		Name:       b.store.name,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (b *circuitBreaker) transition(state esv1.SecretStoreCircuitBreakerState) {
+	b.state = state
+	b.lastTransitionTime = time.Now()
+
+	var value float64
+	switch state {
+	case esv1.CircuitBreakerHalfOpen:
+		value = 1
+	case esv1.CircuitBreakerOpen:
+		value = 2
+	case esv1.CircuitBreakerClosed:
+	}
+	metrics.SetStoreCircuitBreakerState(b.store.kind, b.store.namespace, b.store.name, value)
+
+	var obj esv1.GenericStore = &esv1.SecretStore{}
+	if b.store.kind == esv1.ClusterSecretStoreKind {
+		obj = &esv1.ClusterSecretStore{}
+	}
+	obj.SetName(b.store.name)
+	obj.SetNamespace(b.store.namespace)
+	select {
+	case circuitBreakerEvents[b.store.kind] <- event.GenericEvent{Object: obj}:
+	default:
+	}
+}
+
+func (b *circuitBreaker) status() *esv1.SecretStoreCircuitBreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status := &esv1.SecretStoreCircuitBreakerStatus{
+		State:               b.state,
+		Replica:             replicaName(),
+		ConsecutiveFailures: int32(b.failures), //nolint:gosec // the number of failures is reset on success
+		Message:             b.message,
+	}
+	if !b.lastTransitionTime.IsZero() {
+		status.LastTransitionTime = metav1.NewTime(b.lastTransitionTime)
+	}
+	if b.state == esv1.CircuitBreakerOpen {
+		retryTime := metav1.NewTime(b.retryTime)
+		status.RetryTime = &retryTime
+	}
+	return status
+}
+
+// withCircuitBreaker wraps the client of a store, so its requests towards the provider
+// are rejected while the circuit breaker of the store is open.
+func withCircuitBreaker(secretsClient esv1.SecretsClient, store esv1.GenericStore) esv1.SecretsClient {
+	breaker := storeCircuitBreaker(refForStore(store))
+	if breaker == nil {
+		return secretsClient
+	}
+	return &circuitBreakerClient{
+		SecretsClient: secretsClient,
+		breaker:       breaker,
+	}
+}
+
+// circuitBreakerClient records the result of each request towards the provider in the circuit breaker of the store.
+type circuitBreakerClient struct {
+	esv1.SecretsClient
+	breaker *circuitBreaker
+}
+
+// done records the result of a request. Only errors which show that the provider is unavailable count as failures:
+// secrets that do not exist and errors of a single request, like a missing property or permission,
+// are a valid response of the provider, and requests that were canceled by the controller do not tell anything about the provider.
+func (c *circuitBreakerClient) done(ctx context.Context, err error) {
+	if !isTransientError(err) {
+		err = nil
+	}
+	if err != nil && ctx.Err() != nil {
+		c.breaker.cancel()
+		return
+	}
+	c.breaker.record(err)
+}
+
+func (c *circuitBreakerClient) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+	secret, err := c.SecretsClient.GetSecret(ctx, ref)
+	c.done(ctx, err)
+	return secret, err
+}
+
+// GetSecretVersion is not recorded, as the version belongs to a secret which was already fetched.
+func (c *circuitBreakerClient) GetSecretVersion(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (string, error) {
+	return GetSecretVersion(ctx, c.SecretsClient, ref)
+}
+
+func (c *circuitBreakerClient) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+	secretMap, err := c.SecretsClient.GetSecretMap(ctx, ref)
+	c.done(ctx, err)
+	return secretMap, err
+}
+
+func (c *circuitBreakerClient) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+	secretMap, err := c.SecretsClient.GetAllSecrets(ctx, ref)
+	c.done(ctx, err)
+	return secretMap, err
+}
+
+func (c *circuitBreakerClient) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	if err := c.breaker.allow(); err != nil {
+		return err
+	}
+	err := c.SecretsClient.PushSecret(ctx, secret, data)
+	c.done(ctx, err)
+	return err
+}
+
+func (c *circuitBreakerClient) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	if err := c.breaker.allow(); err != nil {
+		return err
+	}
+	err := c.SecretsClient.DeleteSecret(ctx, remoteRef)
+	c.done(ctx, err)
+	return err
+}
+
+func (c *circuitBreakerClient) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	if err := c.breaker.allow(); err != nil {
+		return false, err
+	}
+	exists, err := c.SecretsClient.SecretExists(ctx, remoteRef)
+	c.done(ctx, err)
+	return exists, err
+}
@@ -0,0 +1,152 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Cleanup(func() {
+		SetCircuitBreaker(0, 30*time.Second, 5*time.Minute)
+	})
+	store := &esv1.SecretStore{ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"}}
+	ref := esv1.ExternalSecretDataRemoteRef{Key: "foo"}
+	errProvider := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	t.Run("disabled", func(t *testing.T) {
+		SetCircuitBreaker(0, time.Second, time.Second)
+		secretsClient := fake.New()
+		assert.Same(t, esv1.SecretsClient(secretsClient), withCircuitBreaker(secretsClient, store))
+		assert.Nil(t, circuitBreakerStatus(store))
+	})
+
+	t.Run("opens after consecutive failures and closes after a successful probe", func(t *testing.T) {
+		SetCircuitBreaker(2, 20*time.Millisecond, time.Second)
+		provider := fake.New().WithGetSecret(nil, errProvider)
+		secretsClient := withCircuitBreaker(provider, store)
+
+		for range 2 {
+			_, err := secretsClient.GetSecret(context.Background(), ref)
+			require.ErrorIs(t, err, errProvider)
+		}
+		status := circuitBreakerStatus(store)
+		assert.Equal(t, esv1.CircuitBreakerOpen, status.State)
+		assert.Equal(t, int32(2), status.ConsecutiveFailures)
+		assert.Equal(t, errProvider.Error(), status.Message)
+		assert.Equal(t, replicaName(), status.Replica)
+		require.NotNil(t, status.RetryTime)
+
+		_, err := secretsClient.GetSecret(context.Background(), ref)
+		var storeUnavailable *StoreUnavailableError
+		require.ErrorAs(t, err, &storeUnavailable)
+		assert.Equal(t, esv1.SecretStoreKind, storeUnavailable.Kind)
+		assert.Equal(t, "vault", storeUnavailable.Name)
+		assert.Positive(t, storeUnavailable.RetryAfter)
+
+		time.Sleep(25 * time.Millisecond)
+		provider.WithGetSecret([]byte("bar"), nil)
+		got, err := secretsClient.GetSecret(context.Background(), ref)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("bar"), got)
+		status = circuitBreakerStatus(store)
+		assert.Equal(t, esv1.CircuitBreakerClosed, status.State)
+		assert.Zero(t, status.ConsecutiveFailures)
+		assert.Nil(t, status.RetryTime)
+	})
+
+	t.Run("doubles the timeout after a failed probe", func(t *testing.T) {
+		SetCircuitBreaker(1, 20*time.Millisecond, 30*time.Millisecond)
+		provider := fake.New().WithGetSecret(nil, errProvider)
+		secretsClient := withCircuitBreaker(provider, store)
+
+		_, err := secretsClient.GetSecret(context.Background(), ref)
+		require.ErrorIs(t, err, errProvider)
+		time.Sleep(25 * time.Millisecond)
+
+		_, err = secretsClient.GetSecret(context.Background(), ref)
+		require.ErrorIs(t, err, errProvider)
+		breaker := storeCircuitBreaker(refForStore(store))
+		assert.Equal(t, 30*time.Millisecond, breaker.openTimeout)
+		assert.Equal(t, esv1.CircuitBreakerOpen, circuitBreakerStatus(store).State)
+	})
+
+	t.Run("allows a single probe while half-open", func(t *testing.T) {
+		SetCircuitBreaker(1, time.Millisecond, time.Second)
+		breaker := storeCircuitBreaker(refForStore(store))
+		breaker.record(errProvider)
+		time.Sleep(2 * time.Millisecond)
+
+		require.NoError(t, breaker.allow())
+		assert.Equal(t, esv1.CircuitBreakerHalfOpen, breaker.status().State)
+		var storeUnavailable *StoreUnavailableError
+		require.ErrorAs(t, breaker.allow(), &storeUnavailable)
+		breaker.cancel()
+		require.NoError(t, breaker.allow())
+	})
+
+	t.Run("missing secrets are no failures", func(t *testing.T) {
+		SetCircuitBreaker(1, time.Second, time.Second)
+		secretsClient := withCircuitBreaker(fake.New().WithGetSecret(nil, esv1.NoSecretErr), store)
+		_, err := secretsClient.GetSecret(context.Background(), ref)
+		require.ErrorIs(t, err, esv1.NoSecretErr)
+		assert.Equal(t, esv1.CircuitBreakerClosed, circuitBreakerStatus(store).State)
+	})
+
+	t.Run("configuration errors of a single request are no failures", func(t *testing.T) {
+		SetCircuitBreaker(1, time.Second, time.Second)
+		errConfig := errors.New("property foo does not exist")
+		secretsClient := withCircuitBreaker(fake.New().WithGetSecret(nil, errConfig), store)
+		for range 3 {
+			_, err := secretsClient.GetSecret(context.Background(), ref)
+			require.ErrorIs(t, err, errConfig)
+		}
+		status := circuitBreakerStatus(store)
+		assert.Equal(t, esv1.CircuitBreakerClosed, status.State)
+		assert.Zero(t, status.ConsecutiveFailures)
+	})
+
+	t.Run("configuration errors close a half-open circuit breaker", func(t *testing.T) {
+		SetCircuitBreaker(1, time.Millisecond, time.Second)
+		breaker := storeCircuitBreaker(refForStore(store))
+		breaker.record(errProvider)
+		time.Sleep(2 * time.Millisecond)
+
+		secretsClient := withCircuitBreaker(fake.New().WithGetSecret(nil, errors.New("invalid json")), store)
+		_, err := secretsClient.GetSecret(context.Background(), ref)
+		require.Error(t, err)
+		assert.Equal(t, esv1.CircuitBreakerClosed, circuitBreakerStatus(store).State)
+	})
+
+	t.Run("is removed with the store", func(t *testing.T) {
+		SetCircuitBreaker(1, time.Second, time.Second)
+		breaker := storeCircuitBreaker(refForStore(store))
+		removeCircuitBreaker(refForStore(store))
+		assert.NotSame(t, breaker, storeCircuitBreaker(refForStore(store)))
+	})
+}
@@ -99,6 +99,7 @@ func (m *Manager) GetFromStore(ctx context.Context, store esv1.GenericStore, nam
 	if err != nil {
 		return nil, err
 	}
+	secretClient = withCircuitBreaker(withRateLimit(secretClient, store), store)
 	idx := storeKey(storeProvider)
 	m.clientMap[idx] = &clientVal{
 		client: secretClient,
@@ -141,6 +142,16 @@ func (m *Manager) Get(ctx context.Context, storeRef esv1.SecretStoreRef, namespa
 	return m.GetFromStore(ctx, store, namespace)
 }
 
+// GetSecretVersion returns the version of the provider secret of ref if the client implements esv1.SecretVersionGetter,
+// otherwise it returns an empty string.
+func GetSecretVersion(ctx context.Context, secretsClient esv1.SecretsClient, ref esv1.ExternalSecretDataRemoteRef) (string, error) {
+	getter, ok := secretsClient.(esv1.SecretVersionGetter)
+	if !ok {
+		return "", nil
+	}
+	return getter.GetSecretVersion(ctx, ref)
+}
+
 // returns a previously stored client from the cache if store and store-version match
 // if a client exists for the same provider which points to a different store or store version
 // it will be cleaned up.
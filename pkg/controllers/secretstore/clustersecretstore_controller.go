@@ -67,6 +67,7 @@ func (r *ClusterStoreReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	err := r.Get(ctx, req.NamespacedName, &css)
 	if apierrors.IsNotFound(err) {
 		cssmetrics.RemoveMetrics(req.Namespace, req.Name)
+		removeCircuitBreaker(storeRef{kind: esapi.ClusterSecretStoreKind, namespace: req.Namespace, name: req.Name})
 		return ctrl.Result{}, nil
 	} else if err != nil {
 		log.Error(err, "unable to get ClusterSecretStore")
@@ -90,6 +91,7 @@ func (r *ClusterStoreReconciler) SetupWithManager(mgr ctrl.Manager, opts control
 	if r.PushSecretEnabled {
 		return builder.WithOptions(opts).
 			For(&esapi.ClusterSecretStore{}).
+			WatchesRawSource(circuitBreakerSource(esapi.ClusterSecretStoreKind)).
 			Watches(
 				&esv1alpha1.PushSecret{},
 				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrlreconcile.Request {
@@ -101,5 +103,6 @@ func (r *ClusterStoreReconciler) SetupWithManager(mgr ctrl.Manager, opts control
 
 	return builder.WithOptions(opts).
 		For(&esapi.ClusterSecretStore{}).
+		WatchesRawSource(circuitBreakerSource(esapi.ClusterSecretStoreKind)).
 		Complete(r)
 }
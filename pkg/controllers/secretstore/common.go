@@ -101,6 +101,11 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 		}
 	}()
 
+	// the circuit breaker is shared by all ExternalSecrets and PushSecrets using the store in this replica.
+	status := ss.GetStatus()
+	status.CircuitBreaker = circuitBreakerStatus(ss)
+	ss.SetStatus(status)
+
 	// validateStore modifies the store conditions
 	// we have to patch the status
 	log.V(1).Info("validating")
@@ -131,8 +136,9 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 	}
 
 	capStatus := esapi.SecretStoreStatus{
-		Capabilities: storeProvider.Capabilities(),
-		Conditions:   ss.GetStatus().Conditions,
+		Capabilities:   storeProvider.Capabilities(),
+		Conditions:     ss.GetStatus().Conditions,
+		CircuitBreaker: ss.GetStatus().CircuitBreaker,
 	}
 	ss.SetStatus(capStatus)
 
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatusError is implemented by the response errors of SDKs which expose the HTTP status code, e.g. the AWS SDK.
+type httpStatusError interface {
+	HTTPStatusCode() int
+}
+
+// IsStoreError returns true if the error affects all requests towards the provider of a store
+// rather than a single secret: the circuit breaker of the store is open,
+// the provider is unavailable or it does not accept the credentials of the store.
+func IsStoreError(err error) bool {
+	var storeUnavailable *StoreUnavailableError
+	if errors.As(err, &storeUnavailable) {
+		return true
+	}
+	return isTransientError(err) || isUnauthenticatedError(err)
+}
+
+// isTransientError returns true if the request failed because the provider could not be reached,
+// timed out or failed with a server error. Errors of a single secret, like a missing property,
+// an invalid request or a missing permission on a path, are not transient.
+func isTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var statusErr httpStatusError
+	if errors.As(err, &statusErr) {
+		code := statusErr.HTTPStatusCode()
+		return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.ResourceExhausted:
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// isUnauthenticatedError returns true if the provider rejected the credentials of the store.
+func isUnauthenticatedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.HTTPStatusCode() == http.StatusUnauthorized
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Code() == codes.Unauthenticated
+	}
+	return false
+}
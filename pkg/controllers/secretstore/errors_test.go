@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+type httpError struct {
+	code int
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("http status %d", e.code)
+}
+
+func (e *httpError) HTTPStatusCode() int {
+	return e.code
+}
+
+func TestIsStoreError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"StoreUnavailable":  {err: fmt.Errorf("wrapped: %w", &StoreUnavailableError{}), want: true},
+		"Timeout":           {err: context.DeadlineExceeded, want: true},
+		"Network":           {err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: true},
+		"ServerError":       {err: &httpError{code: 503}, want: true},
+		"Throttled":         {err: &httpError{code: 429}, want: true},
+		"Unauthenticated":   {err: &httpError{code: 401}, want: true},
+		"GRPCUnavailable":   {err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		"GRPCUnauthorized":  {err: status.Error(codes.Unauthenticated, "unauthenticated"), want: true},
+		"NoSecret":          {err: esv1.NoSecretErr, want: false},
+		"Forbidden":         {err: &httpError{code: 403}, want: false},
+		"BadRequest":        {err: &httpError{code: 400}, want: false},
+		"GRPCNotFound":      {err: status.Error(codes.NotFound, "not found"), want: false},
+		"GRPCPermission":    {err: status.Error(codes.PermissionDenied, "denied"), want: false},
+		"MissingProperty":   {err: errors.New("property foo does not exist"), want: false},
+		"InvalidJSONResult": {err: fmt.Errorf("unable to unmarshal secret: %w", errors.New("invalid character")), want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsStoreError(tc.err); got != tc.want {
+				t.Errorf("IsStoreError(%v) = %t, want %t", tc.err, got, tc.want)
+			}
+		})
+	}
+}
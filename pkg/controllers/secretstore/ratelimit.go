@@ -0,0 +1,182 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/feature"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+var (
+	storeRequestsPerSecond float64
+	storeRequestBurst      int
+
+	limitersMu sync.Mutex
+	limiters   = map[storeRef]*rate.Limiter{}
+)
+
+type storeRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func refForStore(store esv1.GenericStore) storeRef {
+	return storeRef{
+		kind:      store.GetKind(),
+		namespace: store.GetNamespace(),
+		name:      store.GetName(),
+	}
+}
+
+func init() {
+	fs := pflag.NewFlagSet("store-rate-limit", pflag.ExitOnError)
+	fs.Float64Var(&storeRequestsPerSecond, "store-requests-per-second", 0, "Limits the requests per second towards the provider of each (Cluster)SecretStore per replica of the controller. "+
+		"Requests exceeding the limit wait for their turn. With sharding every replica has its own limit. The rate limit is disabled if 0.")
+	fs.IntVar(&storeRequestBurst, "store-request-burst", 10, "The number of requests towards the provider of a (Cluster)SecretStore which may exceed --store-requests-per-second at once.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+	})
+}
+
+// SetStoreRateLimit sets the rate limit of the requests towards the provider of each store.
+// A rate of zero disables the rate limit.
+func SetStoreRateLimit(requestsPerSecond float64, burst int) {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	storeRequestsPerSecond = requestsPerSecond
+	storeRequestBurst = burst
+	limiters = map[storeRef]*rate.Limiter{}
+}
+
+// storeLimiter returns the limiter shared by all requests towards the provider of the store
+// or nil if the rate limit is disabled.
+func storeLimiter(ref storeRef) *rate.Limiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	if storeRequestsPerSecond <= 0 {
+		return nil
+	}
+	limiter, ok := limiters[ref]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(storeRequestsPerSecond), max(storeRequestBurst, 1))
+		limiters[ref] = limiter
+	}
+	return limiter
+}
+
+// withRateLimit wraps the client of a store, so its requests towards the provider
+// are smoothed by the rate limit of the store.
+func withRateLimit(secretsClient esv1.SecretsClient, store esv1.GenericStore) esv1.SecretsClient {
+	ref := refForStore(store)
+	limiter := storeLimiter(ref)
+	if limiter == nil {
+		return secretsClient
+	}
+	return &rateLimitedClient{
+		SecretsClient: secretsClient,
+		limiter:       limiter,
+		store:         ref,
+	}
+}
+
+// rateLimitedClient waits for the rate limit of the store before each request towards the provider.
+type rateLimitedClient struct {
+	esv1.SecretsClient
+	limiter *rate.Limiter
+	store   storeRef
+}
+
+func (c *rateLimitedClient) wait(ctx context.Context) error {
+	r := c.limiter.Reserve()
+	if !r.OK() {
+		return errors.New("store rate limit can not be satisfied")
+	}
+	delay := r.Delay()
+	if delay <= 0 {
+		return nil
+	}
+
+	done := metrics.ObserveThrottledStoreRequest(c.store.kind, c.store.namespace, c.store.name)
+	defer done()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
+}
+
+func (c *rateLimitedClient) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.SecretsClient.GetSecret(ctx, ref)
+}
+
+// GetSecretVersion is not limited, as the version belongs to a secret which was already fetched.
+func (c *rateLimitedClient) GetSecretVersion(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (string, error) {
+	return GetSecretVersion(ctx, c.SecretsClient, ref)
+}
+
+func (c *rateLimitedClient) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.SecretsClient.GetSecretMap(ctx, ref)
+}
+
+func (c *rateLimitedClient) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.SecretsClient.GetAllSecrets(ctx, ref)
+}
+
+func (c *rateLimitedClient) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	if err := c.wait(ctx); err != nil {
+		return err
+	}
+	return c.SecretsClient.PushSecret(ctx, secret, data)
+}
+
+func (c *rateLimitedClient) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	if err := c.wait(ctx); err != nil {
+		return err
+	}
+	return c.SecretsClient.DeleteSecret(ctx, remoteRef)
+}
+
+func (c *rateLimitedClient) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	if err := c.wait(ctx); err != nil {
+		return false, err
+	}
+	return c.SecretsClient.SecretExists(ctx, remoteRef)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	t.Cleanup(func() {
+		SetStoreRateLimit(0, 10)
+	})
+	storeA := &esv1.SecretStore{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}}
+	storeB := &esv1.ClusterSecretStore{ObjectMeta: metav1.ObjectMeta{Name: "a"}}
+
+	t.Run("disabled", func(t *testing.T) {
+		SetStoreRateLimit(0, 10)
+		secretsClient := &MockFakeClient{}
+		assert.Same(t, secretsClient, withRateLimit(secretsClient, storeA))
+	})
+
+	t.Run("smooths requests per store", func(t *testing.T) {
+		SetStoreRateLimit(20, 1)
+		clientA := withRateLimit(&MockFakeClient{}, storeA)
+		start := time.Now()
+		for range 3 {
+			_, err := clientA.GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{})
+			require.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+		// clients of the same store share the rate limit, other stores are not affected.
+		clientB := withRateLimit(&MockFakeClient{}, storeB)
+		start = time.Now()
+		_, err := clientB.GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{})
+		require.NoError(t, err)
+		assert.Less(t, time.Since(start), 40*time.Millisecond)
+		_, err = withRateLimit(&MockFakeClient{}, storeA).GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		SetStoreRateLimit(0.1, 1)
+		secretsClient := withRateLimit(&MockFakeClient{}, storeA)
+		_, err := secretsClient.GetSecretMap(context.Background(), esv1.ExternalSecretDataRemoteRef{})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = secretsClient.GetSecretMap(ctx, esv1.ExternalSecretDataRemoteRef{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
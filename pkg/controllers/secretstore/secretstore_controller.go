@@ -67,6 +67,7 @@ func (r *StoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	err := r.Get(ctx, req.NamespacedName, &ss)
 	if apierrors.IsNotFound(err) {
 		ssmetrics.RemoveMetrics(req.Namespace, req.Name)
+		removeCircuitBreaker(storeRef{kind: esapi.SecretStoreKind, namespace: req.Namespace, name: req.Name})
 		return ctrl.Result{}, nil
 	} else if err != nil {
 		log.Error(err, "unable to get SecretStore")
@@ -90,6 +91,7 @@ func (r *StoreReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Opt
 	if r.PushSecretEnabled {
 		return builder.WithOptions(opts).
 			For(&esapi.SecretStore{}).
+			WatchesRawSource(circuitBreakerSource(esapi.SecretStoreKind)).
 			Watches(
 				&esv1alpha1.PushSecret{},
 				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrlreconcile.Request {
@@ -101,5 +103,6 @@ func (r *StoreReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Opt
 
 	return builder.WithOptions(opts).
 		For(&esapi.SecretStore{}).
+		WatchesRawSource(circuitBreakerSource(esapi.SecretStoreKind)).
 		Complete(r)
 }
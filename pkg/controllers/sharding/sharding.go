@@ -0,0 +1,333 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding splits the ExternalSecrets and PushSecrets between the active replicas of the controller.
+// Every replica renews a Lease, the replicas with a live Lease are the members of the shard group.
+// Objects are assigned to the members with rendezvous hashing of their namespace or UID,
+// so only the objects of a joining or leaving replica move to another replica.
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Key defines which field of an object is hashed to assign it to a member.
+type Key string
+
+const (
+	// KeyNamespace assigns all objects of a namespace to the same member.
+	KeyNamespace Key = "namespace"
+	// KeyUID spreads the objects evenly between the members.
+	KeyUID Key = "uid"
+)
+
+const (
+	// LabelGroup is the label of the Leases of the members of a shard group, the value is the controller class.
+	LabelGroup = "sharding.external-secrets.io/group"
+
+	// eventBufferSize is the buffer of the channel of a watch, the sender blocks while it is full.
+	eventBufferSize = 1024
+
+	// releaseTimeout is the time given to delete the Lease when the replica stops.
+	releaseTimeout = 5 * time.Second
+
+	errRenewLease  = "unable to renew lease %s: %w"
+	errListLeases  = "unable to list leases: %w"
+	errListObjects = "unable to list objects to rebalance: %w"
+)
+
+// Shard keeps track of the members of the shard group and decides which objects this replica reconciles.
+// A nil Shard owns all objects.
+type Shard struct {
+	// Client is used to write the Lease of this replica.
+	Client client.Client
+	// Reader is used to read the Leases, it should not be cached.
+	Reader client.Reader
+	Log    logr.Logger
+	// ID identifies this replica, it must be unique within the group.
+	ID string
+	// Namespace of the Leases.
+	Namespace string
+	// Group is the name of the shard group, replicas only share objects with the members of their group.
+	Group string
+	// Key is the field of an object which is hashed to assign it to a member.
+	Key Key
+	// LeaseDuration is the time after which a member is removed from the group if it did not renew its Lease.
+	// The Lease is renewed every third of the duration.
+	LeaseDuration time.Duration
+
+	mu      sync.RWMutex
+	members []string
+	renewed time.Time
+	watches []*watch
+}
+
+// watch enqueues the objects this replica takes over when the members change.
+type watch struct {
+	reader client.Reader
+	list   client.ObjectList
+	events chan event.GenericEvent
+}
+
+// NeedLeaderElection returns false, all replicas are members of the shard group.
+func (s *Shard) NeedLeaderElection() bool {
+	return false
+}
+
+// Start renews the Lease of this replica and updates the members until the context is done.
+// The Lease is deleted when the replica stops, so the other members take over its objects immediately.
+func (s *Shard) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.LeaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		if err := s.sync(ctx); err != nil {
+			s.Log.Error(err, "unable to sync shard members")
+		}
+		select {
+		case <-ctx.Done():
+			s.release()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Owns returns true if this replica reconciles the object.
+// No objects are owned until the members are known.
+func (s *Shard) Owns(obj client.Object) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return owner(s.members, s.key(obj)) == s.ID
+}
+
+// Predicate filters the events of objects which are not owned by this replica.
+func (s *Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(s.Owns)
+}
+
+// Source returns a source which enqueues the objects of the list type this replica takes over
+// when the members of the shard group change.
+func (s *Shard) Source(reader client.Reader, list client.ObjectList) source.Source {
+	w := &watch{
+		reader: reader,
+		list:   list,
+		events: make(chan event.GenericEvent, eventBufferSize),
+	}
+	s.mu.Lock()
+	s.watches = append(s.watches, w)
+	s.mu.Unlock()
+	return source.Channel(w.events, &handler.EnqueueRequestForObject{})
+}
+
+// sync renews the Lease of this replica and updates the members from the live Leases of the group.
+func (s *Shard) sync(ctx context.Context) error {
+	now := time.Now()
+	if err := s.renew(ctx, now); err != nil {
+		// a replica which could not renew its Lease is removed by the other members,
+		// so it must stop reconciling its objects as well.
+		s.mu.RLock()
+		expired := now.Sub(s.renewed) > s.LeaseDuration
+		s.mu.RUnlock()
+		if expired {
+			s.setMembers(ctx, nil)
+		}
+		return err
+	}
+
+	leases := &coordinationv1.LeaseList{}
+	if err := s.Reader.List(ctx, leases, client.InNamespace(s.Namespace), client.MatchingLabels{LabelGroup: s.Group}); err != nil {
+		return fmt.Errorf(errListLeases, err)
+	}
+	members := liveMembers(leases.Items, now)
+	if !slices.Contains(members, s.ID) {
+		members = append(members, s.ID)
+		slices.Sort(members)
+	}
+
+	s.mu.Lock()
+	s.renewed = now
+	s.mu.Unlock()
+	s.setMembers(ctx, members)
+	return nil
+}
+
+// renew creates or updates the Lease of this replica.
+func (s *Shard) renew(ctx context.Context, now time.Time) error {
+	lease := &coordinationv1.Lease{}
+	err := s.Reader.Get(ctx, types.NamespacedName{Name: s.leaseName(), Namespace: s.Namespace}, lease)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf(errRenewLease, s.leaseName(), err)
+	}
+	exists := err == nil
+
+	lease.Name = s.leaseName()
+	lease.Namespace = s.Namespace
+	if lease.Labels == nil {
+		lease.Labels = make(map[string]string)
+	}
+	lease.Labels[LabelGroup] = s.Group
+	lease.Spec.HolderIdentity = ptr.To(s.ID)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(s.LeaseDuration.Seconds()))
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+	if !exists {
+		lease.Spec.AcquireTime = lease.Spec.RenewTime
+		err = s.Client.Create(ctx, lease)
+	} else {
+		err = s.Client.Update(ctx, lease)
+	}
+	if err != nil {
+		return fmt.Errorf(errRenewLease, s.leaseName(), err)
+	}
+	return nil
+}
+
+// release deletes the Lease of this replica.
+func (s *Shard) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: s.leaseName(), Namespace: s.Namespace}}
+	if err := s.Client.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		s.Log.Error(err, "unable to release shard lease", "lease", lease.Name)
+	}
+}
+
+// setMembers updates the members and enqueues the objects this replica takes over.
+func (s *Shard) setMembers(ctx context.Context, members []string) {
+	s.mu.Lock()
+	previous := s.members
+	if slices.Equal(previous, members) {
+		s.mu.Unlock()
+		return
+	}
+	s.members = members
+	watches := slices.Clone(s.watches)
+	s.mu.Unlock()
+
+	s.Log.Info("shard members changed", "members", members)
+	for _, w := range watches {
+		go func() {
+			if err := s.rebalance(ctx, w, previous, members); err != nil {
+				s.Log.Error(err, "unable to rebalance shard")
+			}
+		}()
+	}
+}
+
+// rebalance enqueues the objects of the watch which this replica owns with the new members but not with the previous members.
+func (s *Shard) rebalance(ctx context.Context, w *watch, previous, members []string) error {
+	list := w.list.DeepCopyObject().(client.ObjectList)
+	if err := w.reader.List(ctx, list); err != nil {
+		return fmt.Errorf(errListObjects, err)
+	}
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return fmt.Errorf(errListObjects, err)
+	}
+	for _, o := range objects {
+		obj, ok := o.(client.Object)
+		if !ok {
+			continue
+		}
+		key := s.key(obj)
+		if owner(members, key) != s.ID || owner(previous, key) == s.ID {
+			continue
+		}
+		select {
+		case w.events <- event.GenericEvent{Object: obj}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+// key returns the value of the object which is hashed to assign it to a member.
+func (s *Shard) key(obj client.Object) string {
+	if s.Key == KeyUID {
+		return string(obj.GetUID())
+	}
+	return obj.GetNamespace()
+}
+
+// leaseName returns the name of the Lease of this replica.
+func (s *Shard) leaseName() string {
+	return fmt.Sprintf("%s-shard-%s", s.Group, s.ID)
+}
+
+// liveMembers returns the sorted holders of the Leases which did not expire.
+func liveMembers(leases []coordinationv1.Lease, now time.Time) []string {
+	members := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if expiry.After(now) {
+			members = append(members, *lease.Spec.HolderIdentity)
+		}
+	}
+	slices.Sort(members)
+	return slices.Compact(members)
+}
+
+// owner returns the member with the highest score for the key (rendezvous hashing).
+// Only the keys of a joining or leaving member change their owner.
+func owner(members []string, key string) string {
+	var best string
+	var bestScore uint64
+	for _, member := range members {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(member))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(key))
+		if score := mix(h.Sum64()); best == "" || score > bestScore {
+			best, bestScore = member, score
+		}
+	}
+	return best
+}
+
+// mix spreads the bits of the FNV hash, whose scores of similar inputs are not independent enough for rendezvous hashing.
+// It is the finalizer of splitmix64.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
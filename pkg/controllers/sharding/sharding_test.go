@@ -0,0 +1,158 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOwner(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	keys := make([]string, 3000)
+	counts := map[string]int{}
+	for i := range keys {
+		keys[i] = fmt.Sprintf("namespace-%d", i)
+		counts[owner(members, keys[i])]++
+	}
+	// the keys are spread evenly between the members.
+	for _, member := range members {
+		if counts[member] < 800 || counts[member] > 1200 {
+			t.Errorf("member %s owns %d of %d keys", member, counts[member], len(keys))
+		}
+	}
+
+	// only the keys of a leaving member move.
+	for _, key := range keys {
+		before := owner(members, key)
+		after := owner([]string{"a", "c"}, key)
+		if before != "b" && before != after {
+			t.Fatalf("key %s moved from %s to %s", key, before, after)
+		}
+	}
+
+	if got := owner(nil, "key"); got != "" {
+		t.Errorf("owner without members = %q", got)
+	}
+}
+
+func TestOwnsNilShard(t *testing.T) {
+	var s *Shard
+	if !s.Owns(&corev1.Secret{}) {
+		t.Error("a nil shard must own all objects")
+	}
+}
+
+func TestSync(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	now := time.Now()
+	lease := func(id string, renewed time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default-shard-" + id,
+				Namespace: "external-secrets",
+				Labels:    map[string]string{LabelGroup: "default"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(id),
+				LeaseDurationSeconds: ptr.To[int32](15),
+				RenewTime:            &metav1.MicroTime{Time: renewed},
+			},
+		}
+	}
+	objects := make([]client.Object, 0, 20)
+	for i := range 20 {
+		objects = append(objects, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: fmt.Sprintf("ns-%d", i)}})
+	}
+	objects = append(objects, lease("b", now), lease("expired", now.Add(-time.Minute)))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	s := &Shard{
+		Client:        fakeClient,
+		Reader:        fakeClient,
+		Log:           logr.Discard(),
+		ID:            "a",
+		Namespace:     "external-secrets",
+		Group:         "default",
+		Key:           KeyNamespace,
+		LeaseDuration: 15 * time.Second,
+	}
+	s.Source(fakeClient, &corev1.ConfigMapList{})
+
+	if err := s.sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the lease of this replica is created and the expired member is ignored.
+	own := &coordinationv1.Lease{}
+	if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: "default-shard-a", Namespace: "external-secrets"}, own); err != nil {
+		t.Fatal(err)
+	}
+	if ptr.Deref(own.Spec.HolderIdentity, "") != "a" || own.Labels[LabelGroup] != "default" {
+		t.Errorf("unexpected lease %+v", own)
+	}
+	if got := fmt.Sprint(s.members); got != "[a b]" {
+		t.Errorf("members = %s, want [a b]", got)
+	}
+
+	// the objects this replica took over are enqueued.
+	want := 0
+	for _, obj := range objects[:20] {
+		if s.Owns(obj) {
+			want++
+		}
+	}
+	if want == 0 || want == 20 {
+		t.Fatalf("replica owns %d of 20 objects", want)
+	}
+	for range want {
+		select {
+		case e := <-s.watches[0].events:
+			if !s.Owns(e.Object) {
+				t.Errorf("enqueued object %s which is not owned", e.Object.GetNamespace())
+			}
+		case <-time.After(time.Second):
+			t.Fatal("owned objects were not enqueued")
+		}
+	}
+	select {
+	case e := <-s.watches[0].events:
+		t.Errorf("unexpected event for %s", e.Object.GetNamespace())
+	default:
+	}
+
+	// the lease is deleted when the replica stops.
+	s.release()
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(own), &coordinationv1.Lease{}); err == nil {
+		t.Error("lease was not released")
+	}
+}
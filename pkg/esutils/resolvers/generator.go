@@ -302,6 +302,94 @@ func clusterGeneratorToVirtual(gen *genv1alpha1.ClusterGenerator) (client.Object
 			},
 			Spec: *gen.Spec.Generator.MFASpec,
 		}, nil
+	case genv1alpha1.GeneratorKindDatabaseUser:
+		if gen.Spec.Generator.DatabaseUserSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, DatabaseUserSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.DatabaseUser{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.DatabaseUserKind,
+			},
+			Spec: *gen.Spec.Generator.DatabaseUserSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindServiceAccountToken:
+		if gen.Spec.Generator.ServiceAccountTokenSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, ServiceAccountTokenSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.ServiceAccountToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.ServiceAccountTokenKind,
+			},
+			Spec: *gen.Spec.Generator.ServiceAccountTokenSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindJWT:
+		if gen.Spec.Generator.JWTSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, JWTSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.JWT{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.JWTKind,
+			},
+			Spec: *gen.Spec.Generator.JWTSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindAWSIAMAccessKey:
+		if gen.Spec.Generator.AWSIAMAccessKeySpec == nil {
+			return nil, fmt.Errorf("when kind is %s, AWSIAMAccessKeySpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.AWSIAMAccessKey{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.AWSIAMAccessKeyKind,
+			},
+			Spec: *gen.Spec.Generator.AWSIAMAccessKeySpec,
+		}, nil
+	case genv1alpha1.GeneratorKindGCPServiceAccountKey:
+		if gen.Spec.Generator.GCPServiceAccountKeySpec == nil {
+			return nil, fmt.Errorf("when kind is %s, GCPServiceAccountKeySpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.GCPServiceAccountKey{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.GCPServiceAccountKeyKind,
+			},
+			Spec: *gen.Spec.Generator.GCPServiceAccountKeySpec,
+		}, nil
+	case genv1alpha1.GeneratorKindAzureApplicationSecret:
+		if gen.Spec.Generator.AzureApplicationSecretSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, AzureApplicationSecretSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.AzureApplicationSecret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.AzureApplicationSecretKind,
+			},
+			Spec: *gen.Spec.Generator.AzureApplicationSecretSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindRegistryCredentials:
+		if gen.Spec.Generator.RegistryCredentialsSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, RegistryCredentialsSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.RegistryCredentials{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.RegistryCredentialsKind,
+			},
+			Spec: *gen.Spec.Generator.RegistryCredentialsSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindComposite:
+		if gen.Spec.Generator.CompositeSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, CompositeSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.Composite{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.CompositeKind,
+			},
+			Spec: *gen.Spec.Generator.CompositeSpec,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown kind %s", gen.Spec.Kind)
 	}
@@ -0,0 +1,294 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package azureappsecret provides functionality for generating client secrets for Azure AD applications.
+package azureappsecret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/provider/azure/keyvault"
+)
+
+// Generator implements the creation of Azure AD application client secrets.
+type Generator struct{}
+
+const (
+	defaultDisplayName = "external-secrets"
+
+	graphPublicCloud       = "https://graph.microsoft.com"
+	graphUSGovernmentCloud = "https://graph.microsoft.us"
+	graphChinaCloud        = "https://microsoftgraph.chinacloudapi.cn"
+
+	errNoSpec          = "no config spec provided"
+	errParseSpec       = "unable to parse spec: %w"
+	errParseState      = "unable to parse state: %w"
+	errNoApplicationID = "no application id provided"
+	errCredential      = "unable to create azure credential: %w"
+	errUnsupportedEnv  = "environment type %s is not supported"
+	errAddPassword     = "unable to add client secret: %w"
+	errRemovePassword  = "unable to remove client secret %s: %w"
+	errGraphStatus     = "unexpected status code %d: %s"
+)
+
+// newGraphFunc creates a Microsoft Graph client for the given spec.
+type newGraphFunc func(ctx context.Context, spec *genv1alpha1.AzureApplicationSecretSpec, kube client.Client, namespace string) (*graphClient, error)
+
+// Generate adds a new client secret to the configured application.
+// The key id of the client secret is stored in the state to remove it on cleanup.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, newGraphClient)
+}
+
+// Cleanup removes the client secret that was created by Generate.
+// Client secrets that do not exist anymore are considered removed.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, jsonSpec, previousStatus, kube, namespace, newGraphClient)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, newGraph newGraphFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	spec, err := parseSpec(jsonSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	graph, err := newGraph(ctx, spec, kube, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	displayName := spec.DisplayName
+	if displayName == "" {
+		displayName = defaultDisplayName
+	}
+	cred := passwordCredential{
+		DisplayName: displayName,
+	}
+	if spec.Lifetime != nil {
+		end := time.Now().Add(spec.Lifetime.Duration).UTC()
+		cred.EndDateTime = &end
+	}
+	created, err := graph.addPassword(ctx, spec.ApplicationID, cred)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errAddPassword, err)
+	}
+	state, err := json.Marshal(&genv1alpha1.AzureApplicationSecretState{
+		KeyID: created.KeyID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	out := map[string][]byte{
+		"client_id":     []byte(spec.ApplicationID),
+		"client_secret": []byte(created.SecretText),
+	}
+	if created.EndDateTime != nil {
+		out["expiration"] = []byte(strconv.FormatInt(created.EndDateTime.Unix(), 10))
+	}
+	return out, &apiextensions.JSON{Raw: state}, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string, newGraph newGraphFunc) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.AzureApplicationSecretState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if state.KeyID == "" {
+		return nil
+	}
+	spec, err := parseSpec(jsonSpec)
+	if err != nil {
+		return err
+	}
+	graph, err := newGraph(ctx, spec, kube, namespace)
+	if err != nil {
+		return err
+	}
+	if err := graph.removePassword(ctx, spec.ApplicationID, state.KeyID); err != nil {
+		return fmt.Errorf(errRemovePassword, state.KeyID, err)
+	}
+	return nil
+}
+
+// newGraphClient authenticates the same way as the Azure Key Vault provider.
+func newGraphClient(ctx context.Context, spec *genv1alpha1.AzureApplicationSecretSpec, kube client.Client, namespace string) (*graphClient, error) {
+	baseURL, err := graphURLForType(spec.EnvironmentType)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	useAzureSDK := true
+	cred, _, err := keyvault.NewGeneratorCredential(ctx, kube, clientset.CoreV1(), &esv1.AzureKVProvider{
+		AuthType:          spec.AuthType,
+		TenantID:          spec.TenantID,
+		EnvironmentType:   spec.EnvironmentType,
+		AuthSecretRef:     spec.AuthSecretRef,
+		ServiceAccountRef: spec.ServiceAccountRef,
+		IdentityID:        spec.IdentityID,
+		UseAzureSDK:       &useAzureSDK,
+	}, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(errCredential, err)
+	}
+	return &graphClient{
+		baseURL:    baseURL,
+		credential: cred,
+		httpClient: http.DefaultClient,
+	}, nil
+}
+
+func graphURLForType(t esv1.AzureEnvironmentType) (string, error) {
+	switch t {
+	case esv1.AzureEnvironmentPublicCloud, "":
+		return graphPublicCloud, nil
+	case esv1.AzureEnvironmentUSGovernmentCloud:
+		return graphUSGovernmentCloud, nil
+	case esv1.AzureEnvironmentChinaCloud:
+		return graphChinaCloud, nil
+	default:
+		return "", fmt.Errorf(errUnsupportedEnv, t)
+	}
+}
+
+// passwordCredential is the passwordCredential resource of Microsoft Graph.
+type passwordCredential struct {
+	DisplayName string     `json:"displayName,omitempty"`
+	EndDateTime *time.Time `json:"endDateTime,omitempty"`
+	KeyID       string     `json:"keyId,omitempty"`
+	SecretText  string     `json:"secretText,omitempty"`
+}
+
+// graphClient calls the application endpoints of Microsoft Graph.
+type graphClient struct {
+	baseURL    string
+	credential azcore.TokenCredential
+	httpClient *http.Client
+}
+
+// addPassword adds a client secret to an application.
+// see: https://learn.microsoft.com/en-us/graph/api/application-addpassword
+func (c *graphClient) addPassword(ctx context.Context, appID string, cred passwordCredential) (*passwordCredential, error) {
+	body := map[string]any{"passwordCredential": cred}
+	res, err := c.do(ctx, appID, "addPassword", body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, statusError(res)
+	}
+	var created passwordCredential
+	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// removePassword removes a client secret from an application.
+// see: https://learn.microsoft.com/en-us/graph/api/application-removepassword
+func (c *graphClient) removePassword(ctx context.Context, appID, keyID string) error {
+	res, err := c.do(ctx, appID, "removePassword", map[string]any{"keyId": keyID})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	err = statusError(res)
+	// Graph responds with a bad request if the key id does not exist (anymore).
+	if res.StatusCode == http.StatusBadRequest && strings.Contains(err.Error(), "No password credential found") {
+		return nil
+	}
+	return err
+}
+
+func (c *graphClient) do(ctx context.Context, appID, action string, body any) (*http.Response, error) {
+	token, err := c.credential.GetToken(ctx, policy.TokenRequestOptions{
+		Scopes: []string{c.baseURL + "/.default"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/v1.0/applications(appId='%s')/%s", c.baseURL, url.PathEscape(appID), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Set("Content-Type", "application/json")
+	return c.httpClient.Do(req)
+}
+
+func statusError(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	return fmt.Errorf(errGraphStatus, res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func parseSpec(jsonSpec *apiextensions.JSON) (*genv1alpha1.AzureApplicationSecretSpec, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	var res genv1alpha1.AzureApplicationSecret
+	if err := yaml.Unmarshal(jsonSpec.Raw, &res); err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	if res.Spec.ApplicationID == "" {
+		return nil, errors.New(errNoApplicationID)
+	}
+	return &res.Spec, nil
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.AzureApplicationSecretKind, &Generator{})
+}
@@ -0,0 +1,220 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureappsecret
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	appID    = "00000000-0000-0000-0000-000000000001"
+	testSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: AzureApplicationSecret
+spec:
+  applicationId: 00000000-0000-0000-0000-000000000001
+  displayName: legacy-app
+  lifetime: 720h`
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(_ context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token-for-" + opts.Scopes[0]}, nil
+}
+
+// graphServer fakes the addPassword and removePassword endpoints of Microsoft Graph.
+type graphServer struct {
+	url          string
+	auth         string
+	added        passwordCredential
+	removedKeyID string
+	removeStatus int
+	removeBody   string
+}
+
+func (s *graphServer) newGraph(t *testing.T) newGraphFunc {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.auth = r.Header.Get("Authorization")
+		switch {
+		case r.URL.Path == "/v1.0/applications(appId='"+appID+"')/addPassword":
+			var body struct {
+				PasswordCredential passwordCredential `json:"passwordCredential"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			s.added = body.PasswordCredential
+			_ = json.NewEncoder(w).Encode(passwordCredential{
+				KeyID:       "key-1",
+				SecretText:  "client-secret",
+				EndDateTime: body.PasswordCredential.EndDateTime,
+			})
+		case r.URL.Path == "/v1.0/applications(appId='"+appID+"')/removePassword":
+			var body struct {
+				KeyID string `json:"keyId"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			s.removedKeyID = body.KeyID
+			if s.removeStatus != 0 {
+				w.WriteHeader(s.removeStatus)
+				_, _ = w.Write([]byte(s.removeBody))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	s.url = srv.URL
+	return func(_ context.Context, _ *genv1alpha1.AzureApplicationSecretSpec, _ client.Client, _ string) (*graphClient, error) {
+		return &graphClient{
+			baseURL:    srv.URL,
+			credential: fakeCredential{},
+			httpClient: srv.Client(),
+		}, nil
+	}
+}
+
+func TestGenerateAndCleanup(t *testing.T) {
+	s := &graphServer{}
+	newGraph := s.newGraph(t)
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	kube := clientfake.NewClientBuilder().Build()
+	g := &Generator{}
+
+	before := time.Now()
+	out, state, err := g.generate(context.Background(), spec, kube, "default", newGraph)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-for-"+s.url+"/.default", s.auth)
+	assert.Equal(t, "legacy-app", s.added.DisplayName)
+	require.NotNil(t, s.added.EndDateTime)
+	assert.WithinDuration(t, before.Add(720*time.Hour), *s.added.EndDateTime, time.Minute)
+
+	assert.Equal(t, appID, string(out["client_id"]))
+	assert.Equal(t, "client-secret", string(out["client_secret"]))
+	assert.NotEmpty(t, out["expiration"])
+	assert.JSONEq(t, `{"keyId":"key-1"}`, string(state.Raw))
+
+	require.NoError(t, g.cleanup(context.Background(), spec, state, kube, "default", newGraph))
+	assert.Equal(t, "key-1", s.removedKeyID)
+}
+
+func TestCleanup(t *testing.T) {
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	state := &apiextensions.JSON{Raw: []byte(`{"keyId":"key-1"}`)}
+	tests := []struct {
+		name         string
+		state        *apiextensions.JSON
+		removeStatus int
+		removeBody   string
+		removed      bool
+		wantErr      bool
+	}{
+		{
+			name: "no state is a no-op",
+		},
+		{
+			name:    "removes the client secret",
+			state:   state,
+			removed: true,
+		},
+		{
+			name:         "removed client secret is ignored",
+			state:        state,
+			removeStatus: http.StatusBadRequest,
+			removeBody:   `{"error":{"code":"CannotDeleteOrUpdateNonExistentPassword","message":"No password credential found with keyId key-1."}}`,
+			removed:      true,
+		},
+		{
+			name:         "deleted application is ignored",
+			state:        state,
+			removeStatus: http.StatusNotFound,
+			removed:      true,
+		},
+		{
+			name:         "remove error is returned",
+			state:        state,
+			removeStatus: http.StatusForbidden,
+			removeBody:   `{"error":{"code":"Authorization_RequestDenied"}}`,
+			removed:      true,
+			wantErr:      true,
+		},
+		{
+			name:    "invalid state is returned",
+			state:   &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &graphServer{removeStatus: tt.removeStatus, removeBody: tt.removeBody}
+			err := (&Generator{}).cleanup(context.Background(), spec, tt.state, clientfake.NewClientBuilder().Build(), "default", s.newGraph(t))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.removed, s.removedKeyID != "")
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	s := &graphServer{}
+	newGraph := s.newGraph(t)
+	for name, spec := range map[string]*apiextensions.JSON{
+		"nil spec":               nil,
+		"invalid json":           {Raw: []byte(`no json`)},
+		"missing application id": {Raw: []byte(`{"spec":{"displayName":"foo"}}`)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := (&Generator{}).generate(context.Background(), spec, clientfake.NewClientBuilder().Build(), "default", newGraph)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGraphURLForType(t *testing.T) {
+	for env, want := range map[esv1.AzureEnvironmentType]string{
+		"":                                     graphPublicCloud,
+		esv1.AzureEnvironmentPublicCloud:       graphPublicCloud,
+		esv1.AzureEnvironmentUSGovernmentCloud: graphUSGovernmentCloud,
+		esv1.AzureEnvironmentChinaCloud:        graphChinaCloud,
+	} {
+		got, err := graphURLForType(env)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := graphURLForType(esv1.AzureEnvironmentGermanCloud)
+	assert.Error(t, err)
+}
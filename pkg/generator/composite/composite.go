@@ -0,0 +1,171 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package composite runs multiple generators as a single unit and templates their outputs.
+package composite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"text/template"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested"
+	estemplate "github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+// Generator implements the Composite generator.
+type Generator struct{}
+
+const (
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errParseState    = "unable to parse state: %w"
+	errDuplicateName = "duplicate generator name %q"
+	errGenerator     = "generator %s: %w"
+	errParseTemplate = "unable to parse template at key %s: %w"
+	errExecTemplate  = "unable to execute template at key %s: %w"
+)
+
+// Generate runs all referenced generators and renders the template with their outputs.
+// The states of the referenced generators are returned as a single state, so the
+// statemanager commits or rolls them back together with the rest of the ExternalSecret.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, resolvers.GeneratorRef, genv1alpha1.GetGeneratorByName)
+}
+
+// Cleanup cleans up the state of all referenced generators.
+func (g *Generator) Cleanup(ctx context.Context, _ *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, previousStatus, kube, namespace, genv1alpha1.GetGeneratorByName)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, resolve nested.ResolveFunc, getGenerator nested.GetGeneratorFunc) (_ map[string][]byte, _ genv1alpha1.GeneratorProviderState, err error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+
+	// parse the templates before running any generator,
+	// so an invalid template does not create values that have to be cleaned up again.
+	templates := make(map[string]*template.Template, len(res.Spec.Template))
+	for key, text := range res.Spec.Template {
+		tpl, err := template.New(key).Funcs(estemplate.FuncMap()).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errParseTemplate, key, err)
+		}
+		templates[key] = tpl
+	}
+
+	runner := nested.NewRunner(kube, namespace, resolve, getGenerator, genv1alpha1.CompositeKind)
+	defer func() {
+		if err == nil {
+			return
+		}
+		if cleanupErr := runner.Rollback(ctx); cleanupErr != nil {
+			err = errors.Join(err, cleanupErr)
+		}
+	}()
+
+	var states []genv1alpha1.CompositeGeneratorState
+	data := make(map[string]map[string]string, len(res.Spec.Generators))
+	for i := range res.Spec.Generators {
+		ref := &res.Spec.Generators[i]
+		if _, exists := data[ref.Name]; exists {
+			return nil, nil, fmt.Errorf(errDuplicateName, ref.Name)
+		}
+		output, state, err := runner.Run(ctx, &ref.GeneratorRef)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errGenerator, ref.Name, err)
+		}
+		if state != nil {
+			states = append(states, genv1alpha1.CompositeGeneratorState{
+				Name:     ref.Name,
+				Kind:     state.Kind,
+				Resource: state.Resource,
+				State:    state.State,
+			})
+		}
+		values := make(map[string]string, len(output))
+		for k, v := range output {
+			values[k] = string(v)
+		}
+		data[ref.Name] = values
+	}
+
+	out := make(map[string][]byte, len(templates))
+	for _, key := range sortedKeys(templates) {
+		var buf bytes.Buffer
+		if err := templates[key].Execute(&buf, data); err != nil {
+			return nil, nil, fmt.Errorf(errExecTemplate, key, err)
+		}
+		out[key] = buf.Bytes()
+	}
+
+	if len(states) == 0 {
+		return out, nil, nil
+	}
+	raw, err := json.Marshal(&genv1alpha1.CompositeState{Generators: states})
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, &apiextensions.JSON{Raw: raw}, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string, getGenerator nested.GetGeneratorFunc) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.CompositeState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	states := make([]nested.State, 0, len(state.Generators))
+	for _, st := range state.Generators {
+		states = append(states, nested.State{Kind: st.Kind, Resource: st.Resource, State: st.State})
+	}
+	return nested.Cleanup(ctx, kube, namespace, getGenerator, states)
+}
+
+func sortedKeys(m map[string]*template.Template) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parseSpec(data []byte) (*genv1alpha1.Composite, error) {
+	var spec genv1alpha1.Composite
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.CompositeKind, &Generator{})
+}
@@ -0,0 +1,266 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package databaseuser provides functionality for generating users in PostgreSQL and MySQL databases.
+package databaseuser
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/sethvargo/go-password/password"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+)
+
+// Generator implements the creation of database users.
+type Generator struct{}
+
+const (
+	defaultPrefix         = "eso"
+	defaultPasswordLength = 32
+	usernameSuffixLength  = 10
+
+	errNoSpec         = "no config spec provided"
+	errParseSpec      = "unable to parse spec: %w"
+	errParseState     = "unable to parse state: %w"
+	errUnknownDriver  = "unknown database driver %q"
+	errAdminAuth      = "unable to get admin credentials: %w"
+	errConnect        = "unable to connect to database: %w"
+	errCreateUser     = "unable to create user: %w"
+	errGrantRole      = "unable to grant role %s: %w"
+	errExecStatement  = "unable to execute statement %d: %w"
+	errDropUser       = "unable to drop user %s: %w"
+	errRenderTemplate = "unable to render statement %d: %w"
+)
+
+// openFunc opens a database handle, it matches sql.Open.
+type openFunc func(driverName, dataSourceName string) (*sql.DB, error)
+
+// Generate creates a new database user with a random password.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, sql.Open)
+}
+
+// Cleanup drops the database user that was created by Generate.
+// Users that do not exist anymore are considered dropped.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, jsonSpec, previousStatus, kube, namespace, sql.Open)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, open openFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	spec, d, err := parseSpecAndDialect(jsonSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := connect(ctx, spec, d, kube, namespace, open)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	user, err := newUser(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := createUser(ctx, db, d, spec, user); err != nil {
+		return nil, nil, err
+	}
+
+	state, err := json.Marshal(&genv1alpha1.DatabaseUserState{
+		Username: user.name,
+		Host:     user.host,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return map[string][]byte{
+		"username": []byte(user.name),
+		"password": []byte(user.password),
+		"host":     []byte(spec.Host),
+		"port":     []byte(fmt.Sprintf("%d", d.port(spec))),
+		"database": []byte(spec.Database),
+		"dsn":      []byte(d.dsn(spec, user.name, user.password)),
+	}, &apiextensions.JSON{Raw: state}, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string, open openFunc) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.DatabaseUserState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if state.Username == "" {
+		return nil
+	}
+	spec, d, err := parseSpecAndDialect(jsonSpec)
+	if err != nil {
+		return err
+	}
+	db, err := connect(ctx, spec, d, kube, namespace, open)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	if err := d.dropUser(ctx, db, &user{name: state.Username, host: state.Host}); err != nil {
+		return fmt.Errorf(errDropUser, state.Username, err)
+	}
+	return nil
+}
+
+type user struct {
+	name     string
+	host     string
+	password string
+}
+
+func newUser(spec *genv1alpha1.DatabaseUserSpec) (*user, error) {
+	prefix := spec.User.Prefix
+	if prefix == "" {
+		prefix = defaultPrefix
+	}
+	// lowercase letters and digits only, so the name never needs to be escaped
+	suffix, err := password.Generate(usernameSuffixLength, usernameSuffixLength/2, 0, true, true)
+	if err != nil {
+		return nil, err
+	}
+	length := spec.User.PasswordLength
+	if length == 0 {
+		length = defaultPasswordLength
+	}
+	// letters and digits only, so the password is safe to use in DSNs and statements
+	pass, err := password.Generate(length, length/4, 0, false, true)
+	if err != nil {
+		return nil, err
+	}
+	host := spec.User.Host
+	if host == "" {
+		host = "%"
+	}
+	return &user{
+		name:     prefix + "_" + suffix,
+		host:     host,
+		password: pass,
+	}, nil
+}
+
+// createUser creates the user, grants the roles and executes the statements.
+// The user is dropped again if any of the steps fails.
+func createUser(ctx context.Context, db *sql.DB, d dialect, spec *genv1alpha1.DatabaseUserSpec, u *user) (err error) {
+	if err := d.createUser(ctx, db, u); err != nil {
+		return fmt.Errorf(errCreateUser, err)
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if dropErr := d.dropUser(ctx, db, u); dropErr != nil {
+			err = errors.Join(err, fmt.Errorf(errDropUser, u.name, dropErr))
+		}
+	}()
+	for _, role := range spec.User.Roles {
+		if err := d.grantRole(ctx, db, role, u); err != nil {
+			return fmt.Errorf(errGrantRole, role, err)
+		}
+	}
+	data := map[string]string{
+		"username": d.quoteUser(u),
+		"database": d.quoteIdentifier(spec.Database),
+	}
+	for i, stmt := range spec.User.Statements {
+		tpl, err := template.New("statement").Option("missingkey=error").Parse(stmt)
+		if err != nil {
+			return fmt.Errorf(errRenderTemplate, i, err)
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf(errRenderTemplate, i, err)
+		}
+		if _, err := db.ExecContext(ctx, buf.String()); err != nil {
+			return fmt.Errorf(errExecStatement, i, err)
+		}
+	}
+	return nil
+}
+
+func connect(ctx context.Context, spec *genv1alpha1.DatabaseUserSpec, d dialect, kube client.Client, namespace string, open openFunc) (*sql.DB, error) {
+	username, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      spec.Auth.Username.Name,
+		Key:       spec.Auth.Username.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errAdminAuth, err)
+	}
+	pass, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      spec.Auth.Password.Name,
+		Key:       spec.Auth.Password.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errAdminAuth, err)
+	}
+	db, err := open(d.driverName(), d.dsn(spec, username, pass))
+	if err != nil {
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	return db, nil
+}
+
+func parseSpecAndDialect(jsonSpec *apiextensions.JSON) (*genv1alpha1.DatabaseUserSpec, dialect, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	d, err := dialectFor(res.Spec.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &res.Spec, d, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.DatabaseUser, error) {
+	var spec genv1alpha1.DatabaseUser
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.DatabaseUserKind, &Generator{})
+}
@@ -0,0 +1,247 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseuser
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const postgresSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: DatabaseUser
+spec:
+  driver: postgres
+  host: db.example.com
+  database: app
+  sslMode: require
+  auth:
+    username:
+      name: db-admin
+      key: username
+    password:
+      name: db-admin
+      key: password
+  user:
+    prefix: app
+    roles:
+    - readonly
+    statements:
+    - GRANT CONNECT ON DATABASE {{ .database }} TO {{ .username }}`
+
+const mysqlSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: DatabaseUser
+spec:
+  driver: mysql
+  host: db.example.com
+  database: app
+  auth:
+    username:
+      name: db-admin
+      key: username
+    password:
+      name: db-admin
+      key: password
+  user:
+    statements:
+    - GRANT SELECT ON {{ .database }}.* TO {{ .username }}`
+
+func adminSecret() client.Client {
+	return clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-admin",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("admin-password"),
+		},
+	}).Build()
+}
+
+func mockOpen(t *testing.T, db *sql.DB, wantDriver string) openFunc {
+	return func(driverName, dsn string) (*sql.DB, error) {
+		if driverName != wantDriver {
+			t.Errorf("unexpected driver %s", driverName)
+		}
+		if !strings.Contains(dsn, "admin") {
+			t.Errorf("expected admin credentials in dsn, got %s", dsn)
+		}
+		return db, nil
+	}
+}
+
+func TestGeneratePostgres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectExec(`CREATE ROLE "app_[a-z0-9]{10}" WITH LOGIN PASSWORD '[a-zA-Z0-9]{32}'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`GRANT "readonly" TO "app_`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`GRANT CONNECT ON DATABASE "app" TO "app_[a-z0-9]{10}"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectClose()
+
+	gen := &Generator{}
+	res, state, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(postgresSpec)}, adminSecret(), "default", mockOpen(t, db, "pgx"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	username := string(res["username"])
+	if !strings.HasPrefix(username, "app_") {
+		t.Errorf("unexpected username %s", username)
+	}
+	if len(res["password"]) != defaultPasswordLength {
+		t.Errorf("unexpected password length %d", len(res["password"]))
+	}
+	if string(res["port"]) != "5432" {
+		t.Errorf("unexpected port %s", res["port"])
+	}
+	wantDSN := "postgres://" + username + ":" + string(res["password"]) + "@db.example.com:5432/app?sslmode=require"
+	if string(res["dsn"]) != wantDSN {
+		t.Errorf("unexpected dsn %s, expected %s", res["dsn"], wantDSN)
+	}
+	var st genv1alpha1.DatabaseUserState
+	if err := json.Unmarshal(state.Raw, &st); err != nil {
+		t.Fatal(err)
+	}
+	if st.Username != username {
+		t.Errorf("unexpected state %v", st)
+	}
+}
+
+func TestGenerateDropsUserOnFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectExec(`CREATE ROLE`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`GRANT "readonly"`).WillReturnError(errors.New("role does not exist"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectExec(`DROP OWNED BY "app_[a-z0-9]{10}"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DROP ROLE IF EXISTS "app_[a-z0-9]{10}"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectClose()
+
+	gen := &Generator{}
+	_, _, err = gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(postgresSpec)}, adminSecret(), "default", mockOpen(t, db, "pgx"))
+	if err == nil || !strings.Contains(err.Error(), "unable to grant role readonly") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenerateMySQL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE USER ?@? IDENTIFIED BY ?`)).
+		WithArgs(sqlmock.AnyArg(), "%", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("GRANT SELECT ON `app`.\\* TO 'eso_[a-z0-9]{10}'@'%'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectClose()
+
+	gen := &Generator{}
+	res, _, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(mysqlSpec)}, adminSecret(), "default", mockOpen(t, db, "mysql"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if string(res["port"]) != "3306" {
+		t.Errorf("unexpected port %s", res["port"])
+	}
+	if !strings.HasPrefix(string(res["dsn"]), string(res["username"])+":"+string(res["password"])+"@tcp(db.example.com:3306)/app") {
+		t.Errorf("unexpected dsn %s", res["dsn"])
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	cases := map[string]struct {
+		spec  string
+		state string
+		mock  func(sqlmock.Sqlmock)
+	}{
+		"PostgresDropUser": {
+			spec:  postgresSpec,
+			state: `{"username":"app_abc"}`,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT EXISTS`).WithArgs("app_abc").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectExec(regexp.QuoteMeta(`DROP OWNED BY "app_abc"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`DROP ROLE IF EXISTS "app_abc"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		"PostgresUserAlreadyDropped": {
+			spec:  postgresSpec,
+			state: `{"username":"app_abc"}`,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT EXISTS`).WithArgs("app_abc").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+		},
+		"MySQLDropUser": {
+			spec:  mysqlSpec,
+			state: `{"username":"eso_abc","host":"%"}`,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(`DROP USER IF EXISTS ?@?`)).WithArgs("eso_abc", "%").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.mock(mock)
+			mock.ExpectClose()
+			driver := "pgx"
+			if tc.spec == mysqlSpec {
+				driver = "mysql"
+			}
+			gen := &Generator{}
+			err = gen.cleanup(context.Background(), &apiextensions.JSON{Raw: []byte(tc.spec)}, &apiextensions.JSON{Raw: []byte(tc.state)}, adminSecret(), "default", mockOpen(t, db, driver))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
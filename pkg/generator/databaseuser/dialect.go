@@ -0,0 +1,177 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseuser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	// Registers the pgx database/sql driver.
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+// dialect abstracts the SQL differences between the supported database engines.
+type dialect interface {
+	driverName() string
+	port(spec *genv1alpha1.DatabaseUserSpec) int32
+	dsn(spec *genv1alpha1.DatabaseUserSpec, username, password string) string
+	quoteIdentifier(name string) string
+	quoteUser(u *user) string
+	createUser(ctx context.Context, db *sql.DB, u *user) error
+	grantRole(ctx context.Context, db *sql.DB, role string, u *user) error
+	dropUser(ctx context.Context, db *sql.DB, u *user) error
+}
+
+func dialectFor(driver genv1alpha1.DatabaseUserDriver) (dialect, error) {
+	switch driver {
+	case genv1alpha1.DatabaseUserDriverPostgres:
+		return postgres{}, nil
+	case genv1alpha1.DatabaseUserDriverMySQL:
+		return mysqlDialect{}, nil
+	default:
+		return nil, fmt.Errorf(errUnknownDriver, driver)
+	}
+}
+
+type postgres struct{}
+
+func (postgres) driverName() string {
+	return "pgx"
+}
+
+func (postgres) port(spec *genv1alpha1.DatabaseUserSpec) int32 {
+	if spec.Port != 0 {
+		return spec.Port
+	}
+	return 5432
+}
+
+func (p postgres) dsn(spec *genv1alpha1.DatabaseUserSpec, username, password string) string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(username, password),
+		Host:   net.JoinHostPort(spec.Host, strconv.Itoa(int(p.port(spec)))),
+		Path:   "/" + spec.Database,
+	}
+	if spec.SSLMode != "" {
+		u.RawQuery = url.Values{"sslmode": []string{spec.SSLMode}}.Encode()
+	}
+	return u.String()
+}
+
+func (postgres) quoteIdentifier(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
+
+func (p postgres) quoteUser(u *user) string {
+	return p.quoteIdentifier(u.name)
+}
+
+func (p postgres) createUser(ctx context.Context, db *sql.DB, u *user) error {
+	// PostgreSQL does not support parameters in utility statements.
+	_, err := db.ExecContext(ctx, fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD %s", p.quoteUser(u), pgQuoteLiteral(u.password)))
+	return err
+}
+
+func (p postgres) grantRole(ctx context.Context, db *sql.DB, role string, u *user) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf("GRANT %s TO %s", p.quoteIdentifier(role), p.quoteUser(u)))
+	return err
+}
+
+func (p postgres) dropUser(ctx context.Context, db *sql.DB, u *user) error {
+	var exists bool
+	if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", u.name).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	// privileges and objects owned by the role prevent it from being dropped
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("DROP OWNED BY %s", p.quoteUser(u))); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", p.quoteUser(u)))
+	return err
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) driverName() string {
+	return "mysql"
+}
+
+func (mysqlDialect) port(spec *genv1alpha1.DatabaseUserSpec) int32 {
+	if spec.Port != 0 {
+		return spec.Port
+	}
+	return 3306
+}
+
+func (m mysqlDialect) dsn(spec *genv1alpha1.DatabaseUserSpec, username, password string) string {
+	cfg := mysql.NewConfig()
+	cfg.User = username
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(spec.Host, strconv.Itoa(int(m.port(spec))))
+	cfg.DBName = spec.Database
+	cfg.TLSConfig = spec.SSLMode
+	// escape parameters on the client, account management statements can not be prepared
+	cfg.InterpolateParams = true
+	return cfg.FormatDSN()
+}
+
+func (mysqlDialect) quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (mysqlDialect) quoteUser(u *user) string {
+	return mysqlQuoteLiteral(u.name) + "@" + mysqlQuoteLiteral(u.host)
+}
+
+func (mysqlDialect) createUser(ctx context.Context, db *sql.DB, u *user) error {
+	_, err := db.ExecContext(ctx, "CREATE USER ?@? IDENTIFIED BY ?", u.name, u.host, u.password)
+	return err
+}
+
+func (mysqlDialect) grantRole(ctx context.Context, db *sql.DB, role string, u *user) error {
+	_, err := db.ExecContext(ctx, "GRANT ? TO ?@?", role, u.name, u.host)
+	return err
+}
+
+func (mysqlDialect) dropUser(ctx context.Context, db *sql.DB, u *user) error {
+	_, err := db.ExecContext(ctx, "DROP USER IF EXISTS ?@?", u.name, u.host)
+	return err
+}
+
+// pgQuoteLiteral quotes a string literal, assuming standard_conforming_strings is enabled.
+func pgQuoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// mysqlQuoteLiteral quotes a string literal for MySQL, which treats backslashes as escape characters.
+func mysqlQuoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", "''") + "'"
+}
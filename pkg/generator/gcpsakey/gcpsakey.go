@@ -0,0 +1,183 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gcpsakey provides functionality for generating keys for Google Cloud service accounts.
+package gcpsakey
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	iam "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/provider/gcp/secretmanager"
+)
+
+// Generator implements the creation of service account keys.
+type Generator struct{}
+
+const (
+	// privateKeyType is the Google credentials file format used by the client libraries.
+	privateKeyType = "TYPE_GOOGLE_CREDENTIALS_FILE"
+
+	errNoSpec           = "no config spec provided"
+	errParseSpec        = "unable to parse spec: %w"
+	errParseState       = "unable to parse state: %w"
+	errNoServiceAccount = "no service account email provided"
+	errNewService       = "unable to create iam service: %w"
+	errCreateKey        = "unable to create service account key: %w"
+	errDecodeKey        = "unable to decode service account key: %w"
+	errDeleteKey        = "unable to delete service account key %s: %w"
+)
+
+type tokenSourceFunc func(ctx context.Context, auth esv1.GCPSMAuth, projectID string, storeKind string, kube client.Client, namespace string) (oauth2.TokenSource, error)
+
+type serviceFunc func(ctx context.Context, ts oauth2.TokenSource) (*iam.Service, error)
+
+// Generate creates a new key for the configured service account.
+// The key name is stored in the state to delete the key on cleanup.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, secretmanager.NewTokenSource, newService)
+}
+
+// Cleanup deletes the key that was created by Generate.
+// Keys that do not exist anymore are considered deleted.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, jsonSpec, previousStatus, kube, namespace, secretmanager.NewTokenSource, newService)
+}
+
+func newService(ctx context.Context, ts oauth2.TokenSource) (*iam.Service, error) {
+	return iam.NewService(ctx, option.WithTokenSource(ts))
+}
+
+func (g *Generator) generate(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	namespace string,
+	tokenSource tokenSourceFunc,
+	service serviceFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	res, svc, err := newClient(ctx, jsonSpec, kube, namespace, tokenSource, service)
+	if err != nil {
+		return nil, nil, err
+	}
+	resource := fmt.Sprintf("projects/-/serviceAccounts/%s", res.Spec.ServiceAccountEmail)
+	key, err := svc.Projects.ServiceAccounts.Keys.Create(resource, &iam.CreateServiceAccountKeyRequest{
+		KeyAlgorithm:   res.Spec.KeyAlgorithm,
+		PrivateKeyType: privateKeyType,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, nil, fmt.Errorf(errCreateKey, err)
+	}
+	data, err := base64.StdEncoding.DecodeString(key.PrivateKeyData)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errDecodeKey, err)
+	}
+	state, err := json.Marshal(&genv1alpha1.GCPServiceAccountKeyState{
+		Name: key.Name,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return map[string][]byte{
+		"key.json": data,
+	}, &apiextensions.JSON{Raw: state}, nil
+}
+
+func (g *Generator) cleanup(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	previousStatus genv1alpha1.GeneratorProviderState,
+	kube client.Client,
+	namespace string,
+	tokenSource tokenSourceFunc,
+	service serviceFunc) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.GCPServiceAccountKeyState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if state.Name == "" {
+		return nil
+	}
+	_, svc, err := newClient(ctx, jsonSpec, kube, namespace, tokenSource, service)
+	if err != nil {
+		return err
+	}
+	_, err = svc.Projects.ServiceAccounts.Keys.Delete(state.Name).Context(ctx).Do()
+	var apiErr *googleapi.Error
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound) {
+		return fmt.Errorf(errDeleteKey, state.Name, err)
+	}
+	return nil
+}
+
+func newClient(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	namespace string,
+	tokenSource tokenSourceFunc,
+	service serviceFunc) (*genv1alpha1.GCPServiceAccountKey, *iam.Service, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	if res.Spec.ServiceAccountEmail == "" {
+		return nil, nil, errors.New(errNoServiceAccount)
+	}
+	ts, err := tokenSource(ctx, esv1.GCPSMAuth{
+		SecretRef:                  (*esv1.GCPSMAuthSecretRef)(res.Spec.Auth.SecretRef),
+		WorkloadIdentity:           (*esv1.GCPWorkloadIdentity)(res.Spec.Auth.WorkloadIdentity),
+		WorkloadIdentityFederation: res.Spec.Auth.WorkloadIdentityFederation,
+	}, res.Spec.ProjectID, resolvers.EmptyStoreKind, kube, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	svc, err := service(ctx, ts)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errNewService, err)
+	}
+	return res, svc, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.GCPServiceAccountKey, error) {
+	var spec genv1alpha1.GCPServiceAccountKey
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.GCPServiceAccountKeyKind, &Generator{})
+}
@@ -0,0 +1,160 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpsakey
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	iam "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+const (
+	testSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: GCPServiceAccountKey
+spec:
+  projectID: my-project
+  serviceAccountEmail: legacy-app@my-project.iam.gserviceaccount.com
+  keyAlgorithm: KEY_ALG_RSA_2048
+  auth:
+    secretRef:
+      secretAccessKeySecretRef:
+        name: gcp-creds
+        key: credentials.json`
+	keyName = "projects/my-project/serviceAccounts/legacy-app@my-project.iam.gserviceaccount.com/keys/abc123"
+	keyFile = `{"type":"service_account","private_key_id":"abc123"}`
+)
+
+// iamServer fakes the service account key endpoints of the IAM API.
+type iamServer struct {
+	created    *iam.CreateServiceAccountKeyRequest
+	createPath string
+	deletePath string
+	deleteCode int
+}
+
+func (s *iamServer) start(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			s.createPath = r.URL.Path
+			s.created = &iam.CreateServiceAccountKeyRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(s.created))
+			_ = json.NewEncoder(w).Encode(&iam.ServiceAccountKey{
+				Name:           keyName,
+				PrivateKeyData: base64.StdEncoding.EncodeToString([]byte(keyFile)),
+			})
+		case http.MethodDelete:
+			s.deletePath = r.URL.Path
+			if s.deleteCode != 0 {
+				w.WriteHeader(s.deleteCode)
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"error": map[string]any{"code": s.deleteCode, "message": http.StatusText(s.deleteCode)},
+				})
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func fakeTokenSource(_ context.Context, _ esv1.GCPSMAuth, _, _ string, _ client.Client, _ string) (oauth2.TokenSource, error) {
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}), nil
+}
+
+func fakeService(srv *httptest.Server) serviceFunc {
+	return func(ctx context.Context, _ oauth2.TokenSource) (*iam.Service, error) {
+		return iam.NewService(ctx, option.WithEndpoint(srv.URL), option.WithHTTPClient(srv.Client()))
+	}
+}
+
+func TestGenerateAndCleanup(t *testing.T) {
+	s := &iamServer{}
+	svc := fakeService(s.start(t))
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	kube := clientfake.NewClientBuilder().Build()
+	g := &Generator{}
+
+	out, state, err := g.generate(context.Background(), spec, kube, "default", fakeTokenSource, svc)
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/projects/-/serviceAccounts/legacy-app@my-project.iam.gserviceaccount.com/keys", s.createPath)
+	assert.Equal(t, "KEY_ALG_RSA_2048", s.created.KeyAlgorithm)
+	assert.Equal(t, privateKeyType, s.created.PrivateKeyType)
+	assert.Equal(t, keyFile, string(out["key.json"]))
+	assert.JSONEq(t, `{"name":"`+keyName+`"}`, string(state.Raw))
+
+	require.NoError(t, g.cleanup(context.Background(), spec, state, kube, "default", fakeTokenSource, svc))
+	assert.Equal(t, "/v1/"+keyName, s.deletePath)
+}
+
+func TestCleanup(t *testing.T) {
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	kube := clientfake.NewClientBuilder().Build()
+
+	t.Run("no state is a no-op", func(t *testing.T) {
+		s := &iamServer{}
+		err := (&Generator{}).cleanup(context.Background(), spec, nil, kube, "default", fakeTokenSource, fakeService(s.start(t)))
+		assert.NoError(t, err)
+		assert.Empty(t, s.deletePath)
+	})
+
+	t.Run("deleted key is ignored", func(t *testing.T) {
+		s := &iamServer{deleteCode: http.StatusNotFound}
+		state := &apiextensions.JSON{Raw: []byte(`{"name":"` + keyName + `"}`)}
+		err := (&Generator{}).cleanup(context.Background(), spec, state, kube, "default", fakeTokenSource, fakeService(s.start(t)))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, s.deletePath)
+	})
+
+	t.Run("delete error is returned", func(t *testing.T) {
+		s := &iamServer{deleteCode: http.StatusForbidden}
+		state := &apiextensions.JSON{Raw: []byte(`{"name":"` + keyName + `"}`)}
+		err := (&Generator{}).cleanup(context.Background(), spec, state, kube, "default", fakeTokenSource, fakeService(s.start(t)))
+		assert.Error(t, err)
+	})
+}
+
+func TestGenerateErrors(t *testing.T) {
+	s := &iamServer{}
+	svc := fakeService(s.start(t))
+	for name, spec := range map[string]*apiextensions.JSON{
+		"nil spec":                nil,
+		"invalid json":            {Raw: []byte(`no json`)},
+		"missing service account": {Raw: []byte(`{"spec":{"projectID":"my-project"}}`)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := (&Generator{}).generate(context.Background(), spec, clientfake.NewClientBuilder().Build(), "default", fakeTokenSource, svc)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iamaccesskey implements a generator for AWS IAM user access keys.
+package iamaccesskey
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	awsauth "github.com/external-secrets/external-secrets/pkg/provider/aws/auth"
+)
+
+// iamAPI defines the methods needed for the IAM access key generator.
+type iamAPI interface {
+	CreateAccessKey(ctx context.Context, params *iam.CreateAccessKeyInput, optFns ...func(*iam.Options)) (*iam.CreateAccessKeyOutput, error)
+	DeleteAccessKey(ctx context.Context, params *iam.DeleteAccessKeyInput, optFns ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
+}
+
+// Generator implements a generator for AWS IAM user access keys.
+type Generator struct{}
+
+// const error messages.
+const (
+	errNoSpec       = "no config spec provided"
+	errParseSpec    = "unable to parse spec: %w"
+	errParseState   = "unable to parse state: %w"
+	errNoUserName   = "no user name provided"
+	errCreateSess   = "unable to create aws session: %w"
+	errCreateKey    = "unable to create access key: %w"
+	errDeleteKey    = "unable to delete access key %s: %w"
+	errNoAccessKeys = "no access key found"
+)
+
+// Generate creates an access key for the configured IAM user.
+// The access key ID is stored in the state to delete the key on cleanup.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, iamFactory)
+}
+
+// Cleanup deletes the access key that was created by Generate.
+// Access keys that do not exist anymore are considered deleted.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, jsonSpec, previousStatus, kube, namespace, iamFactory)
+}
+
+func (g *Generator) generate(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	namespace string,
+	iamFunc iamFactoryFunc,
+) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	res, api, err := newClient(ctx, jsonSpec, kube, namespace, iamFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := api.CreateAccessKey(ctx, &iam.CreateAccessKeyInput{
+		UserName: aws.String(res.Spec.UserName),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf(errCreateKey, err)
+	}
+	if out.AccessKey == nil {
+		return nil, nil, errors.New(errNoAccessKeys)
+	}
+	accessKeyID := aws.ToString(out.AccessKey.AccessKeyId)
+	state, err := json.Marshal(&genv1alpha1.AWSIAMAccessKeyState{
+		AccessKeyID: accessKeyID,
+		UserName:    aws.ToString(out.AccessKey.UserName),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return map[string][]byte{
+		"access_key_id":     []byte(accessKeyID),
+		"secret_access_key": []byte(aws.ToString(out.AccessKey.SecretAccessKey)),
+	}, &apiextensions.JSON{Raw: state}, nil
+}
+
+func (g *Generator) cleanup(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	previousStatus genv1alpha1.GeneratorProviderState,
+	kube client.Client,
+	namespace string,
+	iamFunc iamFactoryFunc,
+) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.AWSIAMAccessKeyState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if state.AccessKeyID == "" {
+		return nil
+	}
+	_, api, err := newClient(ctx, jsonSpec, kube, namespace, iamFunc)
+	if err != nil {
+		return err
+	}
+	_, err = api.DeleteAccessKey(ctx, &iam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(state.AccessKeyID),
+		UserName:    aws.String(state.UserName),
+	})
+	var notFound *iamtypes.NoSuchEntityException
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf(errDeleteKey, state.AccessKeyID, err)
+	}
+	return nil
+}
+
+func newClient(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, iamFunc iamFactoryFunc) (*genv1alpha1.AWSIAMAccessKey, iamAPI, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	if res.Spec.UserName == "" {
+		return nil, nil, errors.New(errNoUserName)
+	}
+	cfg, err := awsauth.NewGeneratorSession(
+		ctx,
+		esv1.AWSAuth{
+			SecretRef: (*esv1.AWSAuthSecretRef)(res.Spec.Auth.SecretRef),
+			JWTAuth:   (*esv1.AWSJWTAuth)(res.Spec.Auth.JWTAuth),
+		},
+		res.Spec.Role,
+		res.Spec.Region,
+		kube,
+		namespace,
+		awsauth.DefaultSTSProvider,
+		awsauth.DefaultJWTProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errCreateSess, err)
+	}
+	return res, iamFunc(cfg), nil
+}
+
+type iamFactoryFunc func(cfg *aws.Config) iamAPI
+
+func iamFactory(cfg *aws.Config) iamAPI {
+	return iam.NewFromConfig(*cfg)
+}
+
+func parseSpec(data []byte) (*genv1alpha1.AWSIAMAccessKey, error) {
+	var spec genv1alpha1.AWSIAMAccessKey
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.AWSIAMAccessKeyKind, &Generator{})
+}
@@ -0,0 +1,172 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamaccesskey
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: AWSIAMAccessKey
+spec:
+  region: eu-west-1
+  userName: legacy-app
+  auth:
+    secretRef:
+      accessKeyIDSecretRef:
+        name: "my-aws-creds"
+        key: "key-id"
+      secretAccessKeySecretRef:
+        name: "my-aws-creds"
+        key: "access-secret"`
+
+type fakeIAM struct {
+	createInput *iam.CreateAccessKeyInput
+	deleteInput *iam.DeleteAccessKeyInput
+	deleteErr   error
+}
+
+func (f *fakeIAM) CreateAccessKey(_ context.Context, input *iam.CreateAccessKeyInput, _ ...func(*iam.Options)) (*iam.CreateAccessKeyOutput, error) {
+	f.createInput = input
+	return &iam.CreateAccessKeyOutput{
+		AccessKey: &iamtypes.AccessKey{
+			AccessKeyId:     aws.String("AKIAEXAMPLE"),
+			SecretAccessKey: aws.String("secret-access-key"),
+			UserName:        input.UserName,
+		},
+	}, nil
+}
+
+func (f *fakeIAM) DeleteAccessKey(_ context.Context, input *iam.DeleteAccessKeyInput, _ ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error) {
+	f.deleteInput = input
+	if f.deleteErr != nil {
+		return nil, f.deleteErr
+	}
+	return &iam.DeleteAccessKeyOutput{}, nil
+}
+
+func newKube() *clientfake.ClientBuilder {
+	return clientfake.NewClientBuilder().WithObjects(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-aws-creds",
+			Namespace: "foobar",
+		},
+		Data: map[string][]byte{
+			"key-id":        []byte("foo"),
+			"access-secret": []byte("bar"),
+		},
+	})
+}
+
+func TestGenerateAndCleanup(t *testing.T) {
+	api := &fakeIAM{}
+	factory := func(_ *aws.Config) iamAPI { return api }
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	kube := newKube().Build()
+	g := &Generator{}
+
+	out, state, err := g.generate(context.Background(), spec, kube, "foobar", factory)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-app", aws.ToString(api.createInput.UserName))
+	assert.Equal(t, map[string][]byte{
+		"access_key_id":     []byte("AKIAEXAMPLE"),
+		"secret_access_key": []byte("secret-access-key"),
+	}, out)
+	assert.JSONEq(t, `{"accessKeyID":"AKIAEXAMPLE","userName":"legacy-app"}`, string(state.Raw))
+
+	require.NoError(t, g.cleanup(context.Background(), spec, state, kube, "foobar", factory))
+	assert.Equal(t, "AKIAEXAMPLE", aws.ToString(api.deleteInput.AccessKeyId))
+	assert.Equal(t, "legacy-app", aws.ToString(api.deleteInput.UserName))
+}
+
+func TestCleanup(t *testing.T) {
+	spec := &apiextensions.JSON{Raw: []byte(testSpec)}
+	state := &apiextensions.JSON{Raw: []byte(`{"accessKeyID":"AKIAEXAMPLE","userName":"legacy-app"}`)}
+	tests := []struct {
+		name      string
+		state     *apiextensions.JSON
+		deleteErr error
+		deleted   bool
+		wantErr   bool
+	}{
+		{
+			name: "no state is a no-op",
+		},
+		{
+			name:    "deletes the access key",
+			state:   state,
+			deleted: true,
+		},
+		{
+			name:      "deleted access key is ignored",
+			state:     state,
+			deleteErr: &iamtypes.NoSuchEntityException{Message: aws.String("not found")},
+			deleted:   true,
+		},
+		{
+			name:      "delete error is returned",
+			state:     state,
+			deleteErr: errors.New("boom"),
+			deleted:   true,
+			wantErr:   true,
+		},
+		{
+			name:    "invalid state is returned",
+			state:   &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeIAM{deleteErr: tt.deleteErr}
+			factory := func(_ *aws.Config) iamAPI { return api }
+			err := (&Generator{}).cleanup(context.Background(), spec, tt.state, newKube().Build(), "foobar", factory)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.deleted, api.deleteInput != nil)
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	factory := func(_ *aws.Config) iamAPI { return &fakeIAM{} }
+	for name, spec := range map[string]*apiextensions.JSON{
+		"nil spec":          nil,
+		"invalid json":      {Raw: []byte(`no json`)},
+		"missing user name": {Raw: []byte(`{"spec":{"region":"eu-west-1"}}`)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := (&Generator{}).generate(context.Background(), spec, newKube().Build(), "foobar", factory)
+			assert.Error(t, err)
+		})
+	}
+}
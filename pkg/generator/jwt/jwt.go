@@ -0,0 +1,196 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jwt provides functionality for generating signed JSON Web Tokens.
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	estemplate "github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+// Generator implements the creation of signed JSON Web Tokens.
+type Generator struct{}
+
+const (
+	defaultLifetime = time.Hour
+
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errGetKey        = "unable to get signing key: %w"
+	errParseKey      = "unable to parse signing key: %w"
+	errSetKeyID      = "unable to set key id: %w"
+	errRenderClaims  = "unable to render claims: %w"
+	errParseClaims   = "unable to parse rendered claims: %w"
+	errSetClaim      = "unable to set claim %s: %w"
+	errSign          = "unable to sign token: %w"
+	errInvalidExpiry = "lifetime must be positive"
+)
+
+// nowFunc returns the current time, it matches time.Now.
+type nowFunc func() time.Time
+
+// idFunc returns a unique token id.
+type idFunc func() string
+
+// Generate creates a new token signed with the configured key.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, time.Now, uuid.NewString)
+}
+
+// Cleanup is a no-op, tokens expire on their own.
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, now nowFunc, newID idFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+
+	lifetime := defaultLifetime
+	if spec.Lifetime != nil {
+		lifetime = spec.Lifetime.Duration
+	}
+	if lifetime <= 0 {
+		return nil, nil, errors.New(errInvalidExpiry)
+	}
+
+	material, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      spec.KeyRef.Name,
+		Key:       spec.KeyRef.Key,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGetKey, err)
+	}
+	key, err := parseKey(spec.Algorithm, []byte(material))
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseKey, err)
+	}
+	if spec.KeyID != "" {
+		if err := key.Set(jwk.KeyIDKey, spec.KeyID); err != nil {
+			return nil, nil, fmt.Errorf(errSetKeyID, err)
+		}
+	}
+
+	iat := now().Truncate(time.Second)
+	exp := iat.Add(lifetime)
+	jti := newID()
+	claims, err := renderClaims(spec.Claims, map[string]any{
+		jwt.IssuedAtKey:   iat.Unix(),
+		jwt.ExpirationKey: exp.Unix(),
+		jwt.JwtIDKey:      jti,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := jwt.New()
+	for k, v := range claims {
+		if err := token.Set(k, v); err != nil {
+			return nil, nil, fmt.Errorf(errSetClaim, k, err)
+		}
+	}
+	// the computed claims take precedence over the claims from the template.
+	computed := map[string]any{
+		jwt.IssuedAtKey:   iat,
+		jwt.ExpirationKey: exp,
+		jwt.JwtIDKey:      jti,
+	}
+	for k, v := range computed {
+		if err := token.Set(k, v); err != nil {
+			return nil, nil, fmt.Errorf(errSetClaim, k, err)
+		}
+	}
+
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.SignatureAlgorithm(spec.Algorithm), key))
+	if err != nil {
+		return nil, nil, fmt.Errorf(errSign, err)
+	}
+	return map[string][]byte{
+		"token": signed,
+	}, nil, nil
+}
+
+// parseKey parses the signing key. HMAC keys which are not encoded as JWK are used as raw secret.
+func parseKey(alg genv1alpha1.JWTAlgorithm, data []byte) (jwk.Key, error) {
+	if isHMAC(alg) && !json.Valid(data) {
+		return jwk.FromRaw(data)
+	}
+	return estemplate.ParseJWK(data)
+}
+
+func isHMAC(alg genv1alpha1.JWTAlgorithm) bool {
+	switch jwa.SignatureAlgorithm(alg) {
+	case jwa.HS256, jwa.HS384, jwa.HS512:
+		return true
+	default:
+		return false
+	}
+}
+
+func renderClaims(claimsTpl string, data map[string]any) (map[string]any, error) {
+	claims := map[string]any{}
+	if claimsTpl == "" {
+		return claims, nil
+	}
+	tpl, err := template.New("claims").Funcs(estemplate.FuncMap()).Option("missingkey=error").Parse(claimsTpl)
+	if err != nil {
+		return nil, fmt.Errorf(errRenderClaims, err)
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf(errRenderClaims, err)
+	}
+	if err := yaml.Unmarshal(buf.Bytes(), &claims); err != nil {
+		return nil, fmt.Errorf(errParseClaims, err)
+	}
+	return claims, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.JWT, error) {
+	var spec genv1alpha1.JWT
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.JWTKind, &Generator{})
+}
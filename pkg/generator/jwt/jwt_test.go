@@ -0,0 +1,210 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var testNow = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func pemKey(t *testing.T, key crypto.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func generateToken(t *testing.T, spec string, key []byte) ([]byte, error) {
+	t.Helper()
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-key", Namespace: "default"},
+		Data:       map[string][]byte{"key": key},
+	}).Build()
+	out, state, err := (&Generator{}).generate(context.Background(), &apiextensions.JSON{Raw: []byte(spec)}, kube, "default",
+		func() time.Time { return testNow },
+		func() string { return "test-jti" },
+	)
+	if err != nil {
+		return nil, err
+	}
+	assert.Nil(t, state)
+	return out["token"], nil
+}
+
+func TestGenerateAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hmacKey := []byte("a-very-secret-hmac-key-of-sufficient-length")
+
+	tests := []struct {
+		name   string
+		alg    jwa.SignatureAlgorithm
+		key    []byte
+		verify any
+	}{
+		{name: "RSA", alg: jwa.RS256, key: pemKey(t, rsaKey), verify: &rsaKey.PublicKey},
+		{name: "RSA-PSS", alg: jwa.PS384, key: pemKey(t, rsaKey), verify: &rsaKey.PublicKey},
+		{name: "ECDSA", alg: jwa.ES256, key: pemKey(t, ecKey), verify: &ecKey.PublicKey},
+		{name: "Ed25519", alg: jwa.EdDSA, key: pemKey(t, edKey), verify: edPub},
+		{name: "HMAC raw secret", alg: jwa.HS256, key: hmacKey, verify: hmacKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := fmt.Sprintf(`{"spec":{"algorithm":%q,"keyRef":{"name":"signing-key","key":"key"},"lifetime":"30m"}}`, tt.alg)
+			token, err := generateToken(t, spec, tt.key)
+			require.NoError(t, err)
+
+			parsed, err := jwt.Parse(token, jwt.WithKey(tt.alg, tt.verify), jwt.WithValidate(false))
+			require.NoError(t, err)
+			assert.Equal(t, testNow, parsed.IssuedAt().UTC())
+			assert.Equal(t, testNow.Add(30*time.Minute), parsed.Expiration().UTC())
+			assert.Equal(t, "test-jti", parsed.JwtID())
+		})
+	}
+}
+
+func TestGenerateClaimsAndKeyID(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := jwk.FromRaw(rsaKey)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, "from-jwk"))
+	jwkJSON, err := json.Marshal(key)
+	require.NoError(t, err)
+
+	spec := `apiVersion: generators.external-secrets.io/v1alpha1
+kind: JWT
+spec:
+  algorithm: RS256
+  keyRef:
+    name: signing-key
+    key: key
+  claims: |
+    iss: https://issuer.example.com
+    sub: {{ "billing" | upper }}
+    aud: [orders, payments]
+    session: "{{ .jti }}"
+    iat: 1
+`
+	token, err := generateToken(t, spec, jwkJSON)
+	require.NoError(t, err)
+
+	parsed, err := jwt.Parse(token, jwt.WithKey(jwa.RS256, &rsaKey.PublicKey), jwt.WithValidate(false))
+	require.NoError(t, err)
+	assert.Equal(t, "https://issuer.example.com", parsed.Issuer())
+	assert.Equal(t, "BILLING", parsed.Subject())
+	assert.Equal(t, []string{"orders", "payments"}, parsed.Audience())
+	// computed claims can not be overwritten by the template
+	assert.Equal(t, testNow, parsed.IssuedAt().UTC())
+	assert.Equal(t, testNow.Add(time.Hour), parsed.Expiration().UTC())
+	session, ok := parsed.Get("session")
+	require.True(t, ok)
+	assert.Equal(t, "test-jti", session)
+
+	msg, err := jws.Parse(token)
+	require.NoError(t, err)
+	assert.Equal(t, "from-jwk", msg.Signatures()[0].ProtectedHeaders().KeyID())
+
+	// an explicit key id takes precedence over the key id of the JWK
+	token, err = generateToken(t, `{"spec":{"algorithm":"RS256","keyID":"explicit","keyRef":{"name":"signing-key","key":"key"}}}`, jwkJSON)
+	require.NoError(t, err)
+	msg, err = jws.Parse(token)
+	require.NoError(t, err)
+	assert.Equal(t, "explicit", msg.Signatures()[0].ProtectedHeaders().KeyID())
+}
+
+func TestGenerateErrors(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tests := []struct {
+		name string
+		spec string
+		key  []byte
+	}{
+		{
+			name: "invalid json spec should result in error",
+			spec: `no json`,
+		},
+		{
+			name: "missing secret key should result in error",
+			spec: `{"spec":{"algorithm":"HS256","keyRef":{"name":"signing-key","key":"missing"}}}`,
+			key:  []byte("secret"),
+		},
+		{
+			name: "invalid key should result in error",
+			spec: `{"spec":{"algorithm":"RS256","keyRef":{"name":"signing-key","key":"key"}}}`,
+			key:  []byte("not a key"),
+		},
+		{
+			name: "key not matching the algorithm should result in error",
+			spec: `{"spec":{"algorithm":"RS256","keyRef":{"name":"signing-key","key":"key"}}}`,
+			key:  pemKey(t, ecKey),
+		},
+		{
+			name: "claims which are no object should result in error",
+			spec: `{"spec":{"algorithm":"ES256","keyRef":{"name":"signing-key","key":"key"},"claims":"[1, 2]"}}`,
+			key:  pemKey(t, ecKey),
+		},
+		{
+			name: "unknown template variable should result in error",
+			spec: `{"spec":{"algorithm":"ES256","keyRef":{"name":"signing-key","key":"key"},"claims":"sub: {{ .unknown }}"}}`,
+			key:  pemKey(t, ecKey),
+		},
+		{
+			name: "negative lifetime should result in error",
+			spec: `{"spec":{"algorithm":"ES256","keyRef":{"name":"signing-key","key":"key"},"lifetime":"-1h"}}`,
+			key:  pemKey(t, ecKey),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generateToken(t, tt.spec, tt.key)
+			assert.Error(t, err)
+		})
+	}
+
+	_, _, err = (&Generator{}).Generate(context.Background(), nil, clientfake.NewClientBuilder().Build(), "default")
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake generators to test generators which run nested generators.
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested"
+)
+
+var _ genv1alpha1.Generator = &Generator{}
+
+// Generator is a fake generator which returns a fixed output, state and error.
+type Generator struct {
+	// Kind is the kind of the manifest returned by Resolve, it defaults to the kind of the reference.
+	Kind   string
+	Output map[string][]byte
+	State  genv1alpha1.GeneratorProviderState
+	Err    error
+	// Runs counts the calls to Generate.
+	Runs int
+	// Cleanups records the cleaned up states, it can be shared by multiple generators to check the order.
+	Cleanups *[]string
+}
+
+// Generate returns the configured output, state and error.
+func (f *Generator) Generate(_ context.Context, _ *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	f.Runs++
+	return f.Output, f.State, f.Err
+}
+
+// Cleanup records the cleaned up state.
+func (f *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, state genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	if f.Cleanups != nil {
+		*f.Cleanups = append(*f.Cleanups, string(state.Raw))
+	}
+	return nil
+}
+
+// Resolve resolves the generators by the name of the reference.
+// The kind of the returned manifest is the kind of the generator, so ClusterGenerators can be simulated.
+func Resolve(gens map[string]*Generator) nested.ResolveFunc {
+	return func(_ context.Context, _ client.Client, _ *runtime.Scheme, _ string, ref *esv1.GeneratorRef) (genv1alpha1.Generator, *apiextensions.JSON, error) {
+		gen, ok := gens[ref.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("generator %s not found", ref.Name)
+		}
+		kind := gen.Kind
+		if kind == "" {
+			kind = ref.Kind
+		}
+		return gen, &apiextensions.JSON{Raw: []byte(`{"kind":"` + kind + `","metadata":{"name":"` + ref.Name + `"}}`)}, nil
+	}
+}
+
+// GetGenerator returns the generators by their kind.
+func GetGenerator(gens map[string]*Generator) nested.GetGeneratorFunc {
+	return func(kind string) (genv1alpha1.Generator, bool) {
+		for _, gen := range gens {
+			if gen.Kind == kind {
+				return gen, true
+			}
+		}
+		return nil, false
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nested runs the generators referenced by other generators, like Composite and RegistryCredentials,
+// as a single unit: the generators which already ran are cleaned up again if a later one fails.
+package nested
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/ratelimit"
+)
+
+const (
+	errNested   = "generatorRef must not reference a %s generator"
+	errGenerate = "unable to generate: %w"
+	errCleanup  = "unable to cleanup %s generator: %w"
+	errUnknown  = "unknown generator kind"
+)
+
+// ResolveFunc resolves a generator reference, it matches resolvers.GeneratorRef.
+type ResolveFunc func(ctx context.Context, cl client.Client, scheme *runtime.Scheme, namespace string, generatorRef *esv1.GeneratorRef) (genv1alpha1.Generator, *apiextensions.JSON, error)
+
+// GetGeneratorFunc returns the generator implementation of a kind, it matches genv1alpha1.GetGeneratorByName.
+type GetGeneratorFunc func(kind string) (genv1alpha1.Generator, bool)
+
+// State is the state returned by a nested generator, together with the manifest it was generated with.
+type State struct {
+	Kind     string
+	Resource *apiextensions.JSON
+	State    *apiextensions.JSON
+}
+
+// Runner runs nested generators and keeps their states, so they can be rolled back together.
+type Runner struct {
+	kube         client.Client
+	namespace    string
+	resolve      ResolveFunc
+	getGenerator GetGeneratorFunc
+	forbidden    []string
+	states       []State
+}
+
+// NewRunner returns a Runner which resolves the generators in the given namespace.
+// Generators of the forbidden kinds can not be nested, also not through a ClusterGenerator.
+func NewRunner(kube client.Client, namespace string, resolve ResolveFunc, getGenerator GetGeneratorFunc, forbidden ...string) *Runner {
+	return &Runner{
+		kube:         kube,
+		namespace:    namespace,
+		resolve:      resolve,
+		getGenerator: getGenerator,
+		forbidden:    forbidden,
+	}
+}
+
+// Run resolves and runs the referenced generator.
+// It returns the state of the generator, or nil if the generator did not return one.
+// The state is kept by the Runner, so it is cleaned up on Rollback even if the output can not be used.
+func (r *Runner) Run(ctx context.Context, ref *esv1.GeneratorRef) (map[string][]byte, *State, error) {
+	if slices.Contains(r.forbidden, ref.Kind) {
+		return nil, nil, fmt.Errorf(errNested, ref.Kind)
+	}
+	gen, resource, err := r.resolve(ctx, r.kube, r.kube.Scheme(), r.namespace, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	kind, err := resourceKind(resource)
+	if err != nil {
+		return nil, nil, err
+	}
+	if slices.Contains(r.forbidden, kind) {
+		return nil, nil, fmt.Errorf(errNested, kind)
+	}
+	if err := ratelimit.Allow(kind); err != nil {
+		return nil, nil, fmt.Errorf(errGenerate, err)
+	}
+	output, state, err := gen.Generate(ctx, resource, r.kube, r.namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGenerate, err)
+	}
+	if state == nil {
+		return output, nil, nil
+	}
+	st := State{Kind: kind, Resource: resource, State: state}
+	r.states = append(r.states, st)
+	return output, &st, nil
+}
+
+// Rollback cleans up the states of all generators which were run, in reverse order.
+func (r *Runner) Rollback(ctx context.Context) error {
+	err := Cleanup(ctx, r.kube, r.namespace, r.getGenerator, r.states)
+	r.states = nil
+	return err
+}
+
+// Cleanup cleans up the given states of nested generators in reverse order.
+// All states are cleaned up, even if the cleanup of one of them fails.
+func Cleanup(ctx context.Context, kube client.Client, namespace string, getGenerator GetGeneratorFunc, states []State) error {
+	var errs []error
+	for i := len(states) - 1; i >= 0; i-- {
+		st := states[i]
+		gen, ok := getGenerator(st.Kind)
+		if !ok {
+			errs = append(errs, fmt.Errorf(errCleanup, st.Kind, errors.New(errUnknown)))
+			continue
+		}
+		if err := gen.Cleanup(ctx, st.Resource, st.State, kube, namespace); err != nil {
+			errs = append(errs, fmt.Errorf(errCleanup, st.Kind, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func resourceKind(resource *apiextensions.JSON) (string, error) {
+	var meta struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(resource.Raw, &meta); err != nil {
+		return "", err
+	}
+	return meta.Kind, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nested_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested/fake"
+)
+
+func TestRunnerRollback(t *testing.T) {
+	var cleanups []string
+	gens := map[string]*fake.Generator{
+		"first":  {Kind: "Password", State: &apiextensions.JSON{Raw: []byte(`{"id":"1"}`)}, Cleanups: &cleanups},
+		"second": {Kind: "DatabaseUser", State: &apiextensions.JSON{Raw: []byte(`{"id":"2"}`)}, Cleanups: &cleanups},
+		"broken": {Kind: "Webhook", Err: errors.New("boom"), Cleanups: &cleanups},
+	}
+	runner := nested.NewRunner(clientfake.NewFakeClient(), "default", fake.Resolve(gens), fake.GetGenerator(gens))
+	for _, name := range []string{"first", "second"} {
+		_, state, err := runner.Run(context.Background(), &esv1.GeneratorRef{Kind: gens[name].Kind, Name: name})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if state == nil || state.Kind != gens[name].Kind {
+			t.Fatalf("unexpected state %v", state)
+		}
+	}
+	_, _, err := runner.Run(context.Background(), &esv1.GeneratorRef{Kind: "Webhook", Name: "broken"})
+	if err == nil || err.Error() != "unable to generate: boom" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := runner.Rollback(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// states are cleaned up in reverse order
+	want := []string{`{"id":"2"}`, `{"id":"1"}`}
+	if strings.Join(cleanups, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected cleanups %v, expected %v", cleanups, want)
+	}
+	if err := runner.Rollback(context.Background()); err != nil || len(cleanups) != len(want) {
+		t.Errorf("expected a second rollback to do nothing, got cleanups %v, error %v", cleanups, err)
+	}
+}
+
+func TestRunnerForbidden(t *testing.T) {
+	gens := map[string]*fake.Generator{
+		"composite":         {Kind: genv1alpha1.CompositeKind},
+		"cluster-composite": {Kind: genv1alpha1.CompositeKind},
+	}
+	cases := map[string]*esv1.GeneratorRef{
+		"Direct":           {Kind: genv1alpha1.CompositeKind, Name: "composite"},
+		"ClusterGenerator": {Kind: "ClusterGenerator", Name: "cluster-composite"},
+	}
+	for name, ref := range cases {
+		t.Run(name, func(t *testing.T) {
+			runner := nested.NewRunner(clientfake.NewFakeClient(), "default", fake.Resolve(gens), fake.GetGenerator(gens), genv1alpha1.CompositeKind)
+			_, _, err := runner.Run(context.Background(), ref)
+			if err == nil || err.Error() != "generatorRef must not reference a Composite generator" {
+				t.Errorf("unexpected error: %v", err)
+			}
+			for name, g := range gens {
+				if g.Runs != 0 {
+					t.Errorf("generator %s was run", name)
+				}
+			}
+		})
+	}
+}
+
+func TestCleanupUnknownKind(t *testing.T) {
+	var cleanups []string
+	gens := map[string]*fake.Generator{
+		"password": {Kind: "Password", Cleanups: &cleanups},
+	}
+	states := []nested.State{
+		{Kind: "Password", State: &apiextensions.JSON{Raw: []byte(`{"id":"1"}`)}},
+		{Kind: "Unknown", State: &apiextensions.JSON{Raw: []byte(`{"id":"2"}`)}},
+	}
+	err := nested.Cleanup(context.Background(), clientfake.NewFakeClient(), "default", fake.GetGenerator(gens), states)
+	if err == nil || err.Error() != "unable to cleanup Unknown generator: unknown generator kind" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(cleanups) != 1 || cleanups[0] != `{"id":"1"}` {
+		t.Errorf("expected the remaining states to be cleaned up, got %v", cleanups)
+	}
+}
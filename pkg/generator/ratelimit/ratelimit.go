@@ -0,0 +1,161 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits how often generators of a kind can be called across the whole controller.
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/external-secrets/external-secrets/pkg/feature"
+)
+
+// ErrRateLimited is returned if the rate limit of a generator kind is exceeded.
+var ErrRateLimited = errors.New("generator rate limit exceeded")
+
+var (
+	mu       sync.Mutex
+	limiters = map[string]*rate.Limiter{}
+)
+
+func init() {
+	fs := pflag.NewFlagSet("generator-rate-limit", pflag.ExitOnError)
+	fs.Var(&limitsFlag{}, "generator-rate-limit", "Limits how often generators of a kind are called per replica of the controller, "+
+		"e.g. GithubAccessToken=10/1m,QuayAccessToken=1/10s allows 10 calls per minute for GithubAccessToken and one call every 10 seconds for QuayAccessToken.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+	})
+}
+
+// Allow returns an error if the rate limit of the generator kind is exceeded.
+// Generator kinds without a rate limit are always allowed.
+func Allow(kind string) error {
+	mu.Lock()
+	limiter, ok := limiters[kind]
+	mu.Unlock()
+	if !ok {
+		return nil
+	}
+	r := limiter.Reserve()
+	if delay := r.Delay(); delay > 0 {
+		r.Cancel()
+		return fmt.Errorf("%w for kind %s, retry in %s", ErrRateLimited, kind, delay.Round(time.Second))
+	}
+	return nil
+}
+
+// AllowResource returns an error if the rate limit of the kind of the generator manifest is exceeded.
+func AllowResource(resource *apiextensions.JSON) error {
+	var meta struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(resource.Raw, &meta); err != nil {
+		return err
+	}
+	return Allow(meta.Kind)
+}
+
+// SetLimit sets the rate limit of a generator kind to the given number of calls per interval.
+// A limit of zero calls removes the rate limit.
+func SetLimit(kind string, calls int, interval time.Duration) {
+	mu.Lock()
+	defer mu.Unlock()
+	if calls <= 0 {
+		delete(limiters, kind)
+		return
+	}
+	limiters[kind] = rate.NewLimiter(rate.Every(interval/time.Duration(calls)), calls)
+}
+
+type limit struct {
+	calls    int
+	interval time.Duration
+}
+
+// limitsFlag parses a comma separated list of <kind>=<calls>/<interval>.
+type limitsFlag struct {
+	limits map[string]limit
+}
+
+func (f *limitsFlag) String() string {
+	if f == nil || len(f.limits) == 0 {
+		return ""
+	}
+	entries := make([]string, 0, len(f.limits))
+	for kind, l := range f.limits {
+		entries = append(entries, fmt.Sprintf("%s=%d/%s", kind, l.calls, l.interval))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func (f *limitsFlag) Set(value string) error {
+	limits, err := parseLimits(value)
+	if err != nil {
+		return err
+	}
+	if f.limits == nil {
+		f.limits = map[string]limit{}
+	}
+	for kind, l := range limits {
+		f.limits[kind] = l
+		SetLimit(kind, l.calls, l.interval)
+	}
+	return nil
+}
+
+func (f *limitsFlag) Type() string {
+	return "kind=calls/interval"
+}
+
+func parseLimits(value string) (map[string]limit, error) {
+	limits := map[string]limit{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kind, spec, ok := strings.Cut(entry, "=")
+		if !ok || kind == "" {
+			return nil, fmt.Errorf("invalid generator rate limit %q, expected <kind>=<calls>/<interval>", entry)
+		}
+		callsStr, intervalStr, ok := strings.Cut(spec, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid generator rate limit %q, expected <kind>=<calls>/<interval>", entry)
+		}
+		calls, err := strconv.Atoi(callsStr)
+		if err != nil || calls < 1 {
+			return nil, fmt.Errorf("invalid number of calls in generator rate limit %q", entry)
+		}
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid interval in generator rate limit %q", entry)
+		}
+		limits[kind] = limit{calls: calls, interval: interval}
+	}
+	return limits, nil
+}
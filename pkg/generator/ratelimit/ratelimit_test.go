@@ -0,0 +1,76 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLimits(t *testing.T) {
+	limits, err := parseLimits("GithubAccessToken=10/1m, QuayAccessToken=1/10s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limits["GithubAccessToken"] != (limit{calls: 10, interval: time.Minute}) {
+		t.Errorf("unexpected limit %v", limits["GithubAccessToken"])
+	}
+	if limits["QuayAccessToken"] != (limit{calls: 1, interval: 10 * time.Second}) {
+		t.Errorf("unexpected limit %v", limits["QuayAccessToken"])
+	}
+
+	for _, invalid := range []string{"GithubAccessToken", "=1/1m", "GithubAccessToken=10", "GithubAccessToken=0/1m", "GithubAccessToken=x/1m", "GithubAccessToken=1/x", "GithubAccessToken=1/-1m"} {
+		if _, err := parseLimits(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestAllow(t *testing.T) {
+	SetLimit("Test", 2, time.Hour)
+	defer SetLimit("Test", 0, 0)
+
+	for i := range 2 {
+		if err := Allow("Test"); err != nil {
+			t.Fatalf("call %d: unexpected error: %v", i, err)
+		}
+	}
+	if err := Allow("Test"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+	if err := Allow("Other"); err != nil {
+		t.Errorf("unexpected error for kind without limit: %v", err)
+	}
+}
+
+func TestFlag(t *testing.T) {
+	f := &limitsFlag{}
+	if err := f.Set("FlagTest=1/1h"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer SetLimit("FlagTest", 0, 0)
+	if f.String() != "FlagTest=1/1h0m0s" {
+		t.Errorf("unexpected flag value %s", f.String())
+	}
+	if err := Allow("FlagTest"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := Allow("FlagTest"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+}
@@ -20,15 +20,23 @@ package register
 import (
 	// Import all generators for their side effects (registration).
 	_ "github.com/external-secrets/external-secrets/pkg/generator/acr"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/azureappsecret"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/cloudsmith"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/composite"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/databaseuser"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/ecr"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/fake"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/gcpsakey"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/gcr"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/github"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/grafana"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/iamaccesskey"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/jwt"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/mfa"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/password"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/quay"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/registrycredentials"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/serviceaccounttoken"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/sshkey"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/sts"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/uuid"
@@ -0,0 +1,254 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registrycredentials merges the credentials of multiple container registries into a single docker config.
+package registrycredentials
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested"
+)
+
+// Generator implements the merging of registry credentials.
+type Generator struct{}
+
+const (
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errParseState    = "unable to parse state: %w"
+	errInvalidSource = "registry %d: exactly one of generatorRef or auth must be set"
+	errGenerator     = "registry %d: %w"
+	errStaticAuth    = "registry %d: unable to get credentials: %w"
+	errNoCredentials = "registry %d: generator must output username and password or auth"
+	errNoRegistry    = "registry %d: registry must be set, the generator does not output it"
+	errDuplicate     = "registry %d: duplicate registry %q"
+)
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth"`
+}
+
+// Generate generates the credentials of all registries and merges them into a `.dockerconfigjson`.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, resolvers.GeneratorRef, genv1alpha1.GetGeneratorByName)
+}
+
+// Cleanup cleans up the state of the referenced generators.
+func (g *Generator) Cleanup(ctx context.Context, _ *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	return g.cleanup(ctx, previousStatus, kube, namespace, genv1alpha1.GetGeneratorByName)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, resolve nested.ResolveFunc, getGenerator nested.GetGeneratorFunc) (_ map[string][]byte, _ genv1alpha1.GeneratorProviderState, err error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+
+	// the credentials of all registries are generated together,
+	// so generators that already ran are cleaned up again if a later one fails.
+	// Generators that reference other generators can not be nested.
+	runner := nested.NewRunner(kube, namespace, resolve, getGenerator, genv1alpha1.RegistryCredentialsKind, genv1alpha1.CompositeKind)
+	defer func() {
+		if err == nil {
+			return
+		}
+		if cleanupErr := runner.Rollback(ctx); cleanupErr != nil {
+			err = errors.Join(err, cleanupErr)
+		}
+	}()
+
+	var states []genv1alpha1.RegistryCredentialsSourceState
+
+	cfg := dockerConfig{Auths: make(map[string]dockerAuth, len(res.Spec.Registries))}
+	for i := range res.Spec.Registries {
+		src := &res.Spec.Registries[i]
+		var (
+			registry string
+			auth     dockerAuth
+		)
+		switch {
+		case src.GeneratorRef != nil && src.Auth == nil:
+			var state *nested.State
+			registry, auth, state, err = fromGenerator(ctx, i, src, runner)
+			if err != nil {
+				return nil, nil, err
+			}
+			if state != nil {
+				states = append(states, genv1alpha1.RegistryCredentialsSourceState{
+					Kind:     state.Kind,
+					Resource: state.Resource,
+					State:    state.State,
+				})
+			}
+		case src.Auth != nil && src.GeneratorRef == nil:
+			registry = src.Registry
+			auth, err = fromSecret(ctx, i, src.Auth, kube, namespace)
+			if err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, fmt.Errorf(errInvalidSource, i)
+		}
+		if registry == "" {
+			return nil, nil, fmt.Errorf(errNoRegistry, i)
+		}
+		if _, exists := cfg.Auths[registry]; exists {
+			return nil, nil, fmt.Errorf(errDuplicate, i, registry)
+		}
+		cfg.Auths[registry] = auth
+	}
+
+	out, err := json.Marshal(&cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	var state genv1alpha1.GeneratorProviderState
+	if len(states) > 0 {
+		raw, err := json.Marshal(&genv1alpha1.RegistryCredentialsState{Sources: states})
+		if err != nil {
+			return nil, nil, err
+		}
+		state = &apiextensions.JSON{Raw: raw}
+	}
+	return map[string][]byte{
+		corev1.DockerConfigJsonKey: out,
+	}, state, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string, getGenerator nested.GetGeneratorFunc) error {
+	if previousStatus == nil {
+		return nil
+	}
+	var state genv1alpha1.RegistryCredentialsState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	states := make([]nested.State, 0, len(state.Sources))
+	for _, st := range state.Sources {
+		states = append(states, nested.State{Kind: st.Kind, Resource: st.Resource, State: st.State})
+	}
+	return nested.Cleanup(ctx, kube, namespace, getGenerator, states)
+}
+
+// fromGenerator runs the referenced generator and converts its output into a docker config entry.
+// The state of the generator is kept by the runner even if its output can not be used, so it is cleaned up.
+func fromGenerator(ctx context.Context, i int, src *genv1alpha1.RegistryCredentialsSource, runner *nested.Runner) (string, dockerAuth, *nested.State, error) {
+	data, state, err := runner.Run(ctx, src.GeneratorRef)
+	if err != nil {
+		return "", dockerAuth{}, nil, fmt.Errorf(errGenerator, i, err)
+	}
+	registry := src.Registry
+	if registry == "" {
+		registry = registryFromOutput(data)
+	}
+	auth, ok := authFromOutput(data)
+	if !ok {
+		return "", dockerAuth{}, nil, fmt.Errorf(errNoCredentials, i)
+	}
+	return registry, auth, state, nil
+}
+
+func fromSecret(ctx context.Context, i int, auth *genv1alpha1.RegistryCredentialsAuth, kube client.Client, namespace string) (dockerAuth, error) {
+	username, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      auth.Username.Name,
+		Key:       auth.Username.Key,
+	})
+	if err != nil {
+		return dockerAuth{}, fmt.Errorf(errStaticAuth, i, err)
+	}
+	password, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      auth.Password.Name,
+		Key:       auth.Password.Key,
+	})
+	if err != nil {
+		return dockerAuth{}, fmt.Errorf(errStaticAuth, i, err)
+	}
+	return newDockerAuth(username, password), nil
+}
+
+// authFromOutput reads the credentials from the generator output.
+// Generators either output `username` and `password` (ECR, GCR, ACR)
+// or a base64 encoded `auth` (Quay, Cloudsmith).
+func authFromOutput(data map[string][]byte) (dockerAuth, bool) {
+	username, hasUsername := data["username"]
+	password, hasPassword := data["password"]
+	if hasUsername && hasPassword {
+		return newDockerAuth(string(username), string(password)), true
+	}
+	if auth := data["auth"]; len(auth) > 0 {
+		return dockerAuth{Auth: string(auth)}, true
+	}
+	return dockerAuth{}, false
+}
+
+// registryFromOutput reads the registry host from the generator output.
+func registryFromOutput(data map[string][]byte) string {
+	for _, key := range []string{"registry", "proxy_endpoint"} {
+		v := string(data[key])
+		if v == "" {
+			continue
+		}
+		if u, err := url.Parse(v); err == nil && u.Host != "" {
+			return u.Host
+		}
+		return strings.TrimSuffix(v, "/")
+	}
+	return ""
+}
+
+func newDockerAuth(username, password string) dockerAuth {
+	return dockerAuth{
+		Username: username,
+		Password: password,
+		Auth:     b64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+}
+
+func parseSpec(data []byte) (*genv1alpha1.RegistryCredentials, error) {
+	var spec genv1alpha1.RegistryCredentials
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.RegistryCredentialsKind, &Generator{})
+}
@@ -0,0 +1,280 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrycredentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/nested/fake"
+)
+
+const spec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: RegistryCredentials
+spec:
+  registries:
+  - generatorRef:
+      kind: ECRAuthorizationToken
+      name: ecr-eu
+  - registry: 222222222222.dkr.ecr.us-east-1.amazonaws.com
+    generatorRef:
+      kind: ECRAuthorizationToken
+      name: ecr-us
+  - generatorRef:
+      kind: QuayAccessToken
+      name: quay
+  - registry: ghcr.io
+    auth:
+      username:
+        name: ghcr
+        key: username
+      password:
+        name: ghcr
+        key: password`
+
+func ghcrSecret() client.Client {
+	return clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ghcr",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"username": []byte("octocat"),
+			"password": []byte("ghp_token"),
+		},
+	}).Build()
+}
+
+func TestGenerate(t *testing.T) {
+	gens := map[string]*fake.Generator{
+		"ecr-eu": {Output: map[string][]byte{
+			"username":       []byte("AWS"),
+			"password":       []byte("eu-token"),
+			"proxy_endpoint": []byte("https://111111111111.dkr.ecr.eu-west-1.amazonaws.com"),
+		}},
+		"ecr-us": {Output: map[string][]byte{
+			"username":       []byte("AWS"),
+			"password":       []byte("us-token"),
+			"proxy_endpoint": []byte("https://111111111111.dkr.ecr.us-east-1.amazonaws.com"),
+		}},
+		"quay": {Output: map[string][]byte{
+			"registry": []byte("quay.io"),
+			"auth":     []byte("cm9ib3Q6dG9rZW4="),
+		}},
+	}
+	gen := &Generator{}
+	res, state, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(spec)}, ghcrSecret(), "default", fake.Resolve(gens), fake.GetGenerator(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state != nil {
+		t.Errorf("expected no state, got %s", state.Raw)
+	}
+	var cfg dockerConfig
+	if err := json.Unmarshal(res[corev1.DockerConfigJsonKey], &cfg); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]dockerAuth{
+		"111111111111.dkr.ecr.eu-west-1.amazonaws.com": {Username: "AWS", Password: "eu-token", Auth: "QVdTOmV1LXRva2Vu"},
+		"222222222222.dkr.ecr.us-east-1.amazonaws.com": {Username: "AWS", Password: "us-token", Auth: "QVdTOnVzLXRva2Vu"},
+		"quay.io": {Auth: "cm9ib3Q6dG9rZW4="},
+		"ghcr.io": {Username: "octocat", Password: "ghp_token", Auth: "b2N0b2NhdDpnaHBfdG9rZW4="},
+	}
+	if len(cfg.Auths) != len(want) {
+		t.Fatalf("unexpected auths %v", cfg.Auths)
+	}
+	for registry, auth := range want {
+		if cfg.Auths[registry] != auth {
+			t.Errorf("unexpected auth for %s: %v, expected %v", registry, cfg.Auths[registry], auth)
+		}
+	}
+}
+
+func TestGenerateState(t *testing.T) {
+	var cleanups []string
+	sub := &fake.Generator{
+		Kind:     "Webhook",
+		Output:   map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+		State:    &apiextensions.JSON{Raw: []byte(`{"id":"1"}`)},
+		Cleanups: &cleanups,
+	}
+	gens := map[string]*fake.Generator{"sub": sub}
+	gen := &Generator{}
+	_, state, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(`
+spec:
+  registries:
+  - registry: registry.example.com
+    generatorRef:
+      kind: Webhook
+      name: sub`)}, ghcrSecret(), "default", fake.Resolve(gens), fake.GetGenerator(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var st genv1alpha1.RegistryCredentialsState
+	if err := json.Unmarshal(state.Raw, &st); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Sources) != 1 || st.Sources[0].Kind != "Webhook" || string(st.Sources[0].State.Raw) != `{"id":"1"}` {
+		t.Fatalf("unexpected state %s", state.Raw)
+	}
+
+	err = gen.cleanup(context.Background(), state, ghcrSecret(), "default", fake.GetGenerator(gens))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cleanups) != 1 || cleanups[0] != `{"id":"1"}` {
+		t.Errorf("unexpected cleanups %v", cleanups)
+	}
+}
+
+func TestGenerateRollback(t *testing.T) {
+	var cleanups []string
+	first := &fake.Generator{
+		Kind:     "Webhook",
+		Output:   map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+		State:    &apiextensions.JSON{Raw: []byte(`{"id":"1"}`)},
+		Cleanups: &cleanups,
+	}
+	second := &fake.Generator{Err: errors.New("boom")}
+	gens := map[string]*fake.Generator{"first": first, "second": second}
+	gen := &Generator{}
+	_, _, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(`
+spec:
+  registries:
+  - registry: one.example.com
+    generatorRef:
+      kind: Webhook
+      name: first
+  - registry: two.example.com
+    generatorRef:
+      kind: Webhook
+      name: second`)}, ghcrSecret(), "default", fake.Resolve(gens), fake.GetGenerator(gens))
+	if err == nil || !strings.Contains(err.Error(), "registry 1: unable to generate: boom") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cleanups) != 1 {
+		t.Errorf("expected first generator to be cleaned up, got %v", cleanups)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	gens := map[string]*fake.Generator{
+		"token": {Output: map[string][]byte{"token": []byte("abc")}},
+		"creds": {Output: map[string][]byte{"username": []byte("user"), "password": []byte("pass")}},
+	}
+	cases := map[string]struct {
+		spec    string
+		wantErr string
+	}{
+		"NoSource": {
+			spec: `
+spec:
+  registries:
+  - registry: ghcr.io`,
+			wantErr: "registry 0: exactly one of generatorRef or auth must be set",
+		},
+		"NestedGenerator": {
+			spec: `
+spec:
+  registries:
+  - generatorRef:
+      kind: RegistryCredentials
+      name: other`,
+			wantErr: "registry 0: generatorRef must not reference a RegistryCredentials generator",
+		},
+		"NestedComposite": {
+			spec: `
+spec:
+  registries:
+  - registry: ghcr.io
+    generatorRef:
+      kind: Composite
+      name: other`,
+			wantErr: "registry 0: generatorRef must not reference a Composite generator",
+		},
+		"NoCredentials": {
+			spec: `
+spec:
+  registries:
+  - registry: ghcr.io
+    generatorRef:
+      kind: Webhook
+      name: token`,
+			wantErr: "registry 0: generator must output username and password or auth",
+		},
+		"NoRegistry": {
+			spec: `
+spec:
+  registries:
+  - generatorRef:
+      kind: Webhook
+      name: creds`,
+			wantErr: "registry 0: registry must be set",
+		},
+		"Duplicate": {
+			spec: `
+spec:
+  registries:
+  - registry: ghcr.io
+    generatorRef:
+      kind: Webhook
+      name: creds
+  - registry: ghcr.io
+    auth:
+      username:
+        name: ghcr
+        key: username
+      password:
+        name: ghcr
+        key: password`,
+			wantErr: `registry 1: duplicate registry "ghcr.io"`,
+		},
+		"MissingSecret": {
+			spec: `
+spec:
+  registries:
+  - registry: ghcr.io
+    auth:
+      username:
+        name: missing
+        key: username
+      password:
+        name: missing
+        key: password`,
+			wantErr: "registry 0: unable to get credentials",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gen := &Generator{}
+			_, _, err := gen.generate(context.Background(), &apiextensions.JSON{Raw: []byte(tc.spec)}, ghcrSecret(), "default", fake.Resolve(gens), fake.GetGenerator(nil))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("unexpected error: %v, expected %q", err, tc.wantErr)
+			}
+		})
+	}
+}
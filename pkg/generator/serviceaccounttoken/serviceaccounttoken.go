@@ -0,0 +1,200 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package serviceaccounttoken provides functionality for generating Kubernetes service account tokens.
+package serviceaccounttoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	provider "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+)
+
+// Generator implements the creation of service account tokens using the TokenRequest API.
+type Generator struct{}
+
+const (
+	defaultClusterName = "default"
+
+	errNoSpec           = "no config spec provided"
+	errParseSpec        = "unable to parse spec: %w"
+	errNoServiceAccount = "no service account name provided"
+	errLocalConfig      = "unable to get local client config: %w"
+	errRemoteConfig     = "unable to prepare remote cluster auth: %w"
+	errClientset        = "unable to create clientset: %w"
+	errCreateToken      = "unable to create token for service account %s/%s: %w"
+	errLoadCA           = "unable to load CA certificate: %w"
+	errKubeconfig       = "unable to render kubeconfig: %w"
+)
+
+// configFunc returns the client config of the cluster the controller runs in.
+type configFunc func() (*rest.Config, error)
+
+// clientsetFunc creates a clientset for the given client config.
+type clientsetFunc func(cfg *rest.Config) (kubernetes.Interface, error)
+
+// Generate requests a new token for the configured service account.
+// It returns the token, the CA certificate of the API server and optionally a kubeconfig.
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, ctrlcfg.GetConfig, newClientset)
+}
+
+// Cleanup is a no-op, tokens can not be revoked and expire on their own.
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func newClientset(cfg *rest.Config) (kubernetes.Interface, error) {
+	return kubernetes.NewForConfig(cfg)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, localConfig configFunc, newClient clientsetFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+	if spec.ServiceAccountRef.Name == "" {
+		return nil, nil, errors.New(errNoServiceAccount)
+	}
+
+	cfg, err := localConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf(errLocalConfig, err)
+	}
+	// the service account namespace can only be chosen freely in a remote cluster,
+	// in the local cluster tokens are restricted to the namespace of the generator.
+	saNamespace := namespace
+	if spec.Cluster != nil {
+		cfg, err = remoteConfig(ctx, spec.Cluster, cfg, kube, namespace, newClient)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errRemoteConfig, err)
+		}
+		if spec.ServiceAccountRef.Namespace != nil && *spec.ServiceAccountRef.Namespace != "" {
+			saNamespace = *spec.ServiceAccountRef.Namespace
+		}
+	}
+
+	clientset, err := newClient(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errClientset, err)
+	}
+	tr, err := clientset.CoreV1().ServiceAccounts(saNamespace).CreateToken(ctx, spec.ServiceAccountRef.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         spec.ServiceAccountRef.Audiences,
+			ExpirationSeconds: spec.ExpirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf(errCreateToken, saNamespace, spec.ServiceAccountRef.Name, err)
+	}
+
+	ca, err := caData(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errLoadCA, err)
+	}
+	out := map[string][]byte{
+		"token":  []byte(tr.Status.Token),
+		"ca.crt": ca,
+	}
+	if spec.Kubeconfig != nil {
+		kubeconfig, err := renderKubeconfig(spec.Kubeconfig, cfg.Host, ca, tr.Status.Token, saNamespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errKubeconfig, err)
+		}
+		out["kubeconfig"] = kubeconfig
+	}
+	return out, nil, nil
+}
+
+// remoteConfig builds the client config of a remote cluster the same way the kubernetes provider does.
+// Service account auth requests its token from the local cluster.
+func remoteConfig(ctx context.Context, cluster *genv1alpha1.ServiceAccountTokenCluster, localCfg *rest.Config, kube client.Client, namespace string, newClient clientsetFunc) (*rest.Config, error) {
+	localClientset, err := newClient(localCfg)
+	if err != nil {
+		return nil, fmt.Errorf(errClientset, err)
+	}
+	p := &provider.Provider{}
+	return p.NewGeneratorRESTConfig(ctx, kube, localClientset.CoreV1(), &esv1.KubernetesProvider{
+		Server:  cluster.Server,
+		Auth:    cluster.Auth,
+		AuthRef: cluster.AuthRef,
+	}, namespace)
+}
+
+// caData returns the CA certificate of the given config,
+// reading it from disk if it is referenced by file (e.g. for in-cluster configs).
+func caData(cfg *rest.Config) ([]byte, error) {
+	c := rest.CopyConfig(cfg)
+	if err := rest.LoadTLSFiles(c); err != nil {
+		return nil, err
+	}
+	return c.TLSClientConfig.CAData, nil
+}
+
+func renderKubeconfig(kc *genv1alpha1.ServiceAccountTokenKubeconfig, host string, ca []byte, token, namespace string) ([]byte, error) {
+	name := kc.ClusterName
+	if name == "" {
+		name = defaultClusterName
+	}
+	server := kc.Server
+	if server == "" {
+		server = host
+	}
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: ca,
+	}
+	cfg.AuthInfos[name] = &clientcmdapi.AuthInfo{
+		Token: token,
+	}
+	cfg.Contexts[name] = &clientcmdapi.Context{
+		Cluster:   name,
+		AuthInfo:  name,
+		Namespace: namespace,
+	}
+	cfg.CurrentContext = name
+	return clientcmd.Write(*cfg)
+}
+
+func parseSpec(data []byte) (*genv1alpha1.ServiceAccountToken, error) {
+	var spec genv1alpha1.ServiceAccountToken
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.ServiceAccountTokenKind, &Generator{})
+}
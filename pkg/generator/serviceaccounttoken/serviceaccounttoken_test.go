@@ -0,0 +1,211 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	localHost  = "https://10.96.0.1:443"
+	remoteHost = "https://remote.example.com"
+	localCA    = "local-ca"
+	remoteCA   = `-----BEGIN CERTIFICATE-----
+MIICGTCCAZ+gAwIBAgIQCeCTZaz32ci5PhwLBCou8zAKBggqhkjOPQQDAzBOMQsw
+CQYDVQQGEwJVUzEXMBUGA1UEChMORGlnaUNlcnQsIEluYy4xJjAkBgNVBAMTHURp
+Z2lDZXJ0IFRMUyBFQ0MgUDM4NCBSb290IEc1MB4XDTIxMDExNTAwMDAwMFoXDTQ2
+MDExNDIzNTk1OVowTjELMAkGA1UEBhMCVVMxFzAVBgNVBAoTDkRpZ2lDZXJ0LCBJ
+bmMuMSYwJAYDVQQDEx1EaWdpQ2VydCBUTFMgRUNDIFAzODQgUm9vdCBHNTB2MBAG
+ByqGSM49AgEGBSuBBAAiA2IABMFEoc8Rl1Ca3iOCNQfN0MsYndLxf3c1TzvdlHJS
+7cI7+Oz6e2tYIOyZrsn8aLN1udsJ7MgT9U7GCh1mMEy7H0cKPGEQQil8pQgO4CLp
+0zVozptjn4S1mU1YoI71VOeVyaNCMEAwHQYDVR0OBBYEFMFRRVBZqz7nLFr6ICIS
+B4CIfBFqMA4GA1UdDwEB/wQEAwIBhjAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49
+BAMDA2gAMGUCMQCJao1H5+z8blUD2WdsJk6Dxv3J+ysTvLd6jLRl0mlpYxNjOyZQ
+LgGheQaRnUi/wr4CMEfDFXuxoJGZSZOoPHzoRgaLLPIxAJSdYsiJvRmEFOml+wG4
+DXZDjC5Ty3zfDBeWUA==
+-----END CERTIFICATE-----
+`
+)
+
+// tokenServer records token requests and the configs the clientsets were created with.
+type tokenServer struct {
+	configs   []*rest.Config
+	namespace string
+	name      string
+	request   *authenticationv1.TokenRequest
+	err       error
+}
+
+func (s *tokenServer) newClient(cfg *rest.Config) (kubernetes.Interface, error) {
+	s.configs = append(s.configs, cfg)
+	cs := k8sfake.NewClientset()
+	cs.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create := action.(k8stesting.CreateAction)
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		if s.err != nil {
+			return true, nil, s.err
+		}
+		s.namespace = action.GetNamespace()
+		s.name = create.(k8stesting.CreateActionImpl).Name
+		s.request = create.GetObject().(*authenticationv1.TokenRequest)
+		return true, &authenticationv1.TokenRequest{
+			Status: authenticationv1.TokenRequestStatus{Token: "token-for-" + cfg.Host},
+		}, nil
+	})
+	return cs, nil
+}
+
+func localConfig() (*rest.Config, error) {
+	return &rest.Config{
+		Host: localHost,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: []byte(localCA),
+		},
+	}, nil
+}
+
+func TestGenerateLocal(t *testing.T) {
+	srv := &tokenServer{}
+	spec := &apiextensions.JSON{Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: ServiceAccountToken
+spec:
+  serviceAccountRef:
+    name: ci
+    namespace: kube-system
+    audiences:
+    - ci.example.com
+  expirationSeconds: 3600
+  kubeconfig:
+    server: https://api.example.com
+    clusterName: prod
+`)}
+	kube := clientfake.NewClientBuilder().Build()
+
+	out, state, err := (&Generator{}).generate(context.Background(), spec, kube, "default", localConfig, srv.newClient)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	// the namespace of the reference is ignored for the local cluster
+	assert.Equal(t, "default", srv.namespace)
+	assert.Equal(t, "ci", srv.name)
+	assert.Equal(t, []string{"ci.example.com"}, srv.request.Spec.Audiences)
+	assert.Equal(t, int64(3600), *srv.request.Spec.ExpirationSeconds)
+
+	assert.Equal(t, "token-for-"+localHost, string(out["token"]))
+	assert.Equal(t, localCA, string(out["ca.crt"]))
+
+	kubeconfig, err := clientcmd.Load(out["kubeconfig"])
+	require.NoError(t, err)
+	assert.Equal(t, "prod", kubeconfig.CurrentContext)
+	assert.Equal(t, "https://api.example.com", kubeconfig.Clusters["prod"].Server)
+	assert.Equal(t, localCA, string(kubeconfig.Clusters["prod"].CertificateAuthorityData))
+	assert.Equal(t, "token-for-"+localHost, kubeconfig.AuthInfos["prod"].Token)
+	assert.Equal(t, "default", kubeconfig.Contexts["prod"].Namespace)
+}
+
+func TestGenerateRemote(t *testing.T) {
+	srv := &tokenServer{}
+	spec := &apiextensions.JSON{Raw: fmt.Appendf(nil, `apiVersion: generators.external-secrets.io/v1alpha1
+kind: ServiceAccountToken
+spec:
+  serviceAccountRef:
+    name: ci
+    namespace: ci-system
+  cluster:
+    server:
+      url: https://remote.example.com
+      caBundle: %s
+    auth:
+      token:
+        bearerToken:
+          name: remote-auth
+          key: token
+`, base64.StdEncoding.EncodeToString([]byte(remoteCA)))}
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-auth", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("remote-admin-token")},
+	}).Build()
+
+	out, _, err := (&Generator{}).generate(context.Background(), spec, kube, "default", localConfig, srv.newClient)
+	require.NoError(t, err)
+
+	require.Len(t, srv.configs, 2)
+	remote := srv.configs[1]
+	assert.Equal(t, remoteHost, remote.Host)
+	assert.Equal(t, "remote-admin-token", remote.BearerToken)
+	assert.Equal(t, "ci-system", srv.namespace)
+	assert.Equal(t, "token-for-"+remoteHost, string(out["token"]))
+	assert.Equal(t, remoteCA, string(out["ca.crt"]))
+	assert.NotContains(t, out, "kubeconfig")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *apiextensions.JSON
+		err  error
+	}{
+		{
+			name: "no json spec should result in error",
+		},
+		{
+			name: "invalid json spec should result in error",
+			spec: &apiextensions.JSON{Raw: []byte(`no json`)},
+		},
+		{
+			name: "missing service account should result in error",
+			spec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{}}}`)},
+		},
+		{
+			name: "failing token request should result in error",
+			spec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci"}}}`)},
+			err:  errors.New("forbidden"),
+		},
+		{
+			name: "missing remote auth should result in error",
+			spec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci"},"cluster":{"server":{"url":"https://remote.example.com"}}}}`)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &tokenServer{err: tt.err}
+			kube := clientfake.NewClientBuilder().Build()
+			_, _, err := (&Generator{}).generate(context.Background(), tt.spec, kube, "default", localConfig, srv.newClient)
+			assert.Error(t, err)
+		})
+	}
+}
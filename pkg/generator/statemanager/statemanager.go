@@ -19,18 +19,24 @@ package statemanager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	genapi "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
 	"github.com/external-secrets/external-secrets/pkg/feature"
@@ -111,24 +117,60 @@ func (m *Manager) Commit() error {
 func (m *Manager) EnqueueFlagLatestStateForGC(stateKey string) {
 	m.queue = append(m.queue, QueueItem{
 		Commit: func() error {
-			return m.disposeState(stateKey)
+			return m.disposeState(stateKey, DefaultRetention())
 		},
 	})
 }
 
 // EnqueueMoveStateToGC will move the generator state to GC if Commit() is called.
-func (m *Manager) EnqueueMoveStateToGC(stateKey string) {
+// The retention defines how many of the existing states are kept and when the others are cleaned up.
+func (m *Manager) EnqueueMoveStateToGC(stateKey string, retention Retention) {
 	m.queue = append(m.queue, QueueItem{
 		Commit: func() error {
-			return m.disposeState(stateKey)
+			return m.disposeState(stateKey, retention)
 		},
 	})
 }
 
+// Retention defines how many states of a generator are kept and when the others are cleaned up.
+type Retention struct {
+	// KeepLast is the number of states that are kept, including the one that is about to be created.
+	KeepLast int
+	// GCGracePeriod is the time after which a state is cleaned up once it has been flagged for GC.
+	GCGracePeriod time.Duration
+}
+
+// DefaultRetention keeps the previous state until the next rotation
+// and cleans up older states after the configured grace period.
+func DefaultRetention() Retention {
+	return Retention{
+		KeepLast:      2,
+		GCGracePeriod: gcGracePeriod,
+	}
+}
+
+// NewRetention returns the retention of a generator reference.
+func NewRetention(ref *esv1.GeneratorRef) Retention {
+	retention := DefaultRetention()
+	if ref == nil || ref.StateRetention == nil {
+		return retention
+	}
+	if ref.StateRetention.KeepLast != nil && *ref.StateRetention.KeepLast > 0 {
+		retention.KeepLast = int(*ref.StateRetention.KeepLast)
+	}
+	if ref.StateRetention.GCGracePeriod != nil {
+		retention.GCGracePeriod = ref.StateRetention.GCGracePeriod.Duration
+	}
+	return retention
+}
+
 // EnqueueSetLatest sets the latest state for the given key.
 // It will commit the state on success or move the state to GC on failure.
-func (m *Manager) EnqueueSetLatest(ctx context.Context, stateKey, namespace string, resource *apiextensions.JSON, gen genapi.Generator, state genapi.GeneratorProviderState) {
-	if state == nil {
+// If the generator supports renewal or keepOutput is set the generated output is
+// stored alongside the state, so it can be re-used later on.
+// With keepOutput a GeneratorState is created even if the generator did not return a state.
+func (m *Manager) EnqueueSetLatest(ctx context.Context, stateKey, namespace string, resource *apiextensions.JSON, gen genapi.Generator, state genapi.GeneratorProviderState, output map[string][]byte, keepOutput bool) {
+	if state == nil && !keepOutput {
 		return
 	}
 
@@ -139,12 +181,24 @@ func (m *Manager) EnqueueSetLatest(ctx context.Context, stateKey, namespace stri
 			if err != nil {
 				return err
 			}
-			return m.client.Create(ctx, genState)
+			if err := m.client.Create(ctx, genState); err != nil {
+				return err
+			}
+			if keepOutput {
+				return m.storeOutput(ctx, genState, output)
+			}
+			if renewer, ok := gen.(genapi.Renewer); ok && renewer.RenewEnabled(resource) {
+				return m.storeOutput(ctx, genState, output)
+			}
+			return nil
 		},
 		// Rollback by cleaning up the state.
 		// In case of failure, create a new GeneratorState, so it will eventually be cleaned up.
 		// If that also fails we're out of luck :(
 		Rollback: func() error {
+			if state == nil {
+				return nil
+			}
 			err := gen.Cleanup(ctx, resource, state, m.client, namespace)
 			if err == nil {
 				return nil
@@ -161,6 +215,130 @@ func (m *Manager) EnqueueSetLatest(ctx context.Context, stateKey, namespace stri
 	})
 }
 
+// RenewLatest tries to renew the given latest state of the key instead of generating new values.
+// It returns the output that was stored alongside the state if the renewal succeeded,
+// or nil if the generator does not support renewal, the generator manifest changed
+// or the generator requires new values to be generated.
+// The renewed state is persisted on Commit.
+func (m *Manager) RenewLatest(ctx context.Context, latest *genapi.GeneratorState, resource *apiextensions.JSON, gen genapi.Generator) (map[string][]byte, error) {
+	renewer, ok := gen.(genapi.Renewer)
+	if !ok || latest == nil || !renewer.RenewEnabled(resource) {
+		return nil, nil
+	}
+	if !sameSpec(latest.Spec.Resource, resource) {
+		return nil, nil
+	}
+	output, err := m.getOutput(ctx, latest)
+	if err != nil || output == nil {
+		return nil, err
+	}
+	newState, renewed, err := renewer.Renew(ctx, resource, latest.Spec.State, m.client, m.namespace)
+	if err != nil || !renewed {
+		return nil, err
+	}
+	m.queue = append(m.queue, QueueItem{
+		Commit: func() error {
+			latest.Spec.State = newState
+			return m.client.Update(ctx, latest)
+		},
+	})
+	return output, nil
+}
+
+// RegenerationPolicy defines how long generated values are re-used instead of calling the generator again.
+type RegenerationPolicy struct {
+	// MinInterval is the minimum time between two calls to the generator.
+	// The latest values are re-used until it has passed, even if the generator manifest changed.
+	MinInterval time.Duration
+	// MaxAge is the time the latest values are re-used for, as long as the generator manifest did not change.
+	MaxAge time.Duration
+}
+
+// NewRegenerationPolicy returns the regeneration policy of a generator reference.
+func NewRegenerationPolicy(ref *esv1.GeneratorRef) RegenerationPolicy {
+	var policy RegenerationPolicy
+	if ref.MinRegenerationInterval != nil {
+		policy.MinInterval = ref.MinRegenerationInterval.Duration
+	}
+	if ref.MaxAge != nil {
+		policy.MaxAge = ref.MaxAge.Duration
+	}
+	return policy
+}
+
+// Enabled returns true if generated values should be kept for re-use.
+func (p RegenerationPolicy) Enabled() bool {
+	return p.MinInterval > 0 || p.MaxAge > 0
+}
+
+// ReuseLatest returns the output that was stored alongside the latest state of the key
+// if it can be re-used according to the policy, or nil if new values must be generated.
+func (m *Manager) ReuseLatest(ctx context.Context, latest *genapi.GeneratorState, resource *apiextensions.JSON, policy RegenerationPolicy) (map[string][]byte, error) {
+	if latest == nil || !policy.Enabled() {
+		return nil, nil
+	}
+	age := time.Since(latest.CreationTimestamp.Time)
+	reusable := age < policy.MinInterval || (age < policy.MaxAge && sameSpec(latest.Spec.Resource, resource))
+	if !reusable {
+		return nil, nil
+	}
+	return m.getOutput(ctx, latest)
+}
+
+// storeOutput stores the generated output in a Secret that is owned by the GeneratorState.
+// The Secret is garbage collected by Kubernetes together with the GeneratorState.
+// It is labeled as generator output so it can be excluded from backups and audited.
+func (m *Manager) storeOutput(ctx context.Context, genState *genapi.GeneratorState, output map[string][]byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      genState.Name,
+			Namespace: genState.Namespace,
+			Labels: map[string]string{
+				genapi.GeneratorStateLabelOwnerKey: genState.Labels[genapi.GeneratorStateLabelOwnerKey],
+				genapi.GeneratorStateLabelOutput:   genapi.GeneratorStateLabelOutputValue,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: output,
+	}
+	if err := controllerutil.SetControllerReference(genState, secret, m.scheme); err != nil {
+		return err
+	}
+	return m.client.Create(ctx, secret)
+}
+
+func (m *Manager) getOutput(ctx context.Context, genState *genapi.GeneratorState) (map[string][]byte, error) {
+	var secret corev1.Secret
+	err := m.client.Get(ctx, client.ObjectKey{Namespace: genState.Namespace, Name: genState.Name}, &secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(&secret, genState) {
+		return nil, nil
+	}
+	return secret.Data, nil
+}
+
+// sameSpec returns true if both generator manifests have the same spec.
+func sameSpec(a, b *apiextensions.JSON) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	var specA, specB struct {
+		Spec any `json:"spec"`
+	}
+	if err := json.Unmarshal(a.Raw, &specA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b.Raw, &specB); err != nil {
+		return false
+	}
+	return equality.Semantic.DeepEqual(specA.Spec, specB.Spec)
+}
+
 func (m *Manager) createGeneratorState(resource *apiextensions.JSON, state genapi.GeneratorProviderState, namespace, stateKey string) (*genapi.GeneratorState, error) {
 	genState := &genapi.GeneratorState{
 		ObjectMeta: metav1.ObjectMeta{
@@ -193,30 +371,36 @@ func ownerKey(resource genapi.StatefulResource, key string) string {
 	)
 }
 
-func (m *Manager) disposeState(key string) error {
+func (m *Manager) disposeState(key string, retention Retention) error {
 	allStates, err := m.GetAllStates(key)
 	if err != nil {
 		return err
 	}
 
-	latest := getLatest(allStates)
-	if latest == nil {
-		return nil
+	// sort the states which are not yet flagged for GC from newest to oldest.
+	var states []genapi.GeneratorState
+	for _, state := range allStates {
+		if state.Spec.GarbageCollectionDeadline != nil {
+			continue
+		}
+		states = append(states, state)
 	}
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[j].CreationTimestamp.Before(&states[i].CreationTimestamp)
+	})
 
-	// flag all states for GC except the latest one
+	// flag all states for GC except the most recent ones.
+	// The state which is about to be created counts towards KeepLast.
 	// This is to ensure that all "old" states are eventually cleaned up.
 	// This is needed due to fast reconciles and working with stale cache.
+	keep := max(retention.KeepLast-1, 0)
+	if len(states) <= keep {
+		return nil
+	}
 	var errs []error
-	for _, state := range allStates {
-		if state.Name == latest.Name {
-			continue
-		}
-		if state.Spec.GarbageCollectionDeadline != nil {
-			continue
-		}
+	for _, state := range states[keep:] {
 		state.Spec.GarbageCollectionDeadline = &metav1.Time{
-			Time: time.Now().Add(gcGracePeriod),
+			Time: time.Now().Add(retention.GCGracePeriod),
 		}
 		if err := m.client.Update(m.ctx, &state); err != nil {
 			errs = append(errs, err)
@@ -277,3 +461,30 @@ func getLatest(stateList []genapi.GeneratorState) *genapi.GeneratorState {
 	}
 	return latest
 }
+
+// Summarize returns a summary of the given states of the key, sorted by creation time.
+func Summarize(key string, states []genapi.GeneratorState) []esv1.GeneratorStateSummary {
+	summaries := make([]esv1.GeneratorStateSummary, 0, len(states))
+	for _, state := range states {
+		summary := esv1.GeneratorStateSummary{
+			Key:                       key,
+			Name:                      state.Name,
+			CreationTimestamp:         state.CreationTimestamp,
+			GarbageCollectionDeadline: state.Spec.GarbageCollectionDeadline,
+		}
+		for _, cond := range state.Status.Conditions {
+			if cond.Type == genapi.GeneratorStateReady {
+				summary.Ready = cond.Status
+				summary.Message = cond.Message
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].CreationTimestamp.Equal(&summaries[j].CreationTimestamp) {
+			return summaries[i].Name < summaries[j].Name
+		}
+		return summaries[i].CreationTimestamp.Before(&summaries[j].CreationTimestamp)
+	})
+	return summaries
+}
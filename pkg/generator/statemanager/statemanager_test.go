@@ -0,0 +1,395 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genapi "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+type renewingGenerator struct {
+	enabled bool
+	renewed bool
+	calls   int
+}
+
+func (g *renewingGenerator) Generate(_ context.Context, _ *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genapi.GeneratorProviderState, error) {
+	return nil, nil, nil
+}
+
+func (g *renewingGenerator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genapi.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *renewingGenerator) RenewEnabled(_ *apiextensions.JSON) bool {
+	return g.enabled
+}
+
+func (g *renewingGenerator) Renew(_ context.Context, _ *apiextensions.JSON, _ genapi.GeneratorProviderState, _ client.Client, _ string) (genapi.GeneratorProviderState, bool, error) {
+	g.calls++
+	return &apiextensions.JSON{Raw: []byte(`{"renewed":true}`)}, g.renewed, nil
+}
+
+func newTestManager(t *testing.T) (*Manager, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = esv1.AddToScheme(scheme)
+	_ = genapi.AddToScheme(scheme)
+	es := &esv1.ExternalSecret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: esv1.SchemeGroupVersion.String(),
+			Kind:       esv1.ExtSecretKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+			UID:       "es-uid",
+		},
+	}
+	kube := clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(es).Build()
+	return New(context.Background(), kube, scheme, "default", es), kube
+}
+
+func TestRenewLatest(t *testing.T) {
+	resource := &apiextensions.JSON{Raw: []byte(`{"kind":"VaultDynamicSecret","spec":{"path":"database/creds/example"}}`)}
+	output := map[string][]byte{"password": []byte("secret")}
+
+	cases := map[string]struct {
+		gen        *renewingGenerator
+		resource   *apiextensions.JSON
+		wantOutput map[string][]byte
+		wantState  string
+	}{
+		"Renewed": {
+			gen:        &renewingGenerator{enabled: true, renewed: true},
+			resource:   resource,
+			wantOutput: output,
+			wantState:  `{"renewed":true}`,
+		},
+		"NotRenewed": {
+			gen:       &renewingGenerator{enabled: true},
+			resource:  resource,
+			wantState: `{"lease":"a"}`,
+		},
+		"SpecChanged": {
+			gen:       &renewingGenerator{enabled: true, renewed: true},
+			resource:  &apiextensions.JSON{Raw: []byte(`{"kind":"VaultDynamicSecret","spec":{"path":"database/creds/other"}}`)},
+			wantState: `{"lease":"a"}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mgr, kube := newTestManager(t)
+			mgr.EnqueueSetLatest(ctx, "0", "default", resource, tc.gen, &apiextensions.JSON{Raw: []byte(`{"lease":"a"}`)}, output, false)
+			if err := mgr.Commit(); err != nil {
+				t.Fatalf("unable to commit: %v", err)
+			}
+
+			mgr = New(ctx, kube, mgr.scheme, "default", mgr.resource)
+			latest, err := mgr.GetLatestState("0")
+			if err != nil || latest == nil {
+				t.Fatalf("unable to get latest state: %v", err)
+			}
+			got, err := mgr.RenewLatest(ctx, latest, tc.resource, tc.gen)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOutput, got); diff != "" {
+				t.Errorf("unexpected output: -want, +got:\n%s", diff)
+			}
+			if err := mgr.Commit(); err != nil {
+				t.Fatalf("unable to commit: %v", err)
+			}
+			latest, err = mgr.GetLatestState("0")
+			if err != nil {
+				t.Fatalf("unable to get latest state: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantState, string(latest.Spec.State.Raw)); diff != "" {
+				t.Errorf("unexpected state: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnqueueSetLatestStoresOutput(t *testing.T) {
+	resource := &apiextensions.JSON{Raw: []byte(`{"kind":"VaultDynamicSecret","spec":{}}`)}
+	output := map[string][]byte{"password": []byte("secret")}
+
+	for _, enabled := range []bool{true, false} {
+		ctx := context.Background()
+		mgr, kube := newTestManager(t)
+		mgr.EnqueueSetLatest(ctx, "0", "default", resource, &renewingGenerator{enabled: enabled}, &apiextensions.JSON{Raw: []byte(`{}`)}, output, false)
+		if err := mgr.Commit(); err != nil {
+			t.Fatalf("unable to commit: %v", err)
+		}
+		var secrets corev1.SecretList
+		if err := kube.List(ctx, &secrets, client.InNamespace("default")); err != nil {
+			t.Fatal(err)
+		}
+		if enabled && (len(secrets.Items) != 1 || string(secrets.Items[0].Data["password"]) != "secret") {
+			t.Errorf("expected generated output to be stored, got %v", secrets.Items)
+		}
+		if !enabled && len(secrets.Items) != 0 {
+			t.Errorf("expected generated output not to be stored, got %v", secrets.Items)
+		}
+	}
+}
+
+func TestEnqueueSetLatestKeepOutput(t *testing.T) {
+	resource := &apiextensions.JSON{Raw: []byte(`{"kind":"Password","spec":{}}`)}
+	output := map[string][]byte{"password": []byte("secret")}
+
+	ctx := context.Background()
+	mgr, kube := newTestManager(t)
+	// stateless generators get a GeneratorState if the output is kept
+	mgr.EnqueueSetLatest(ctx, "0", "default", resource, &renewingGenerator{}, nil, output, true)
+	if err := mgr.Commit(); err != nil {
+		t.Fatalf("unable to commit: %v", err)
+	}
+	latest, err := mgr.GetLatestState("0")
+	if err != nil || latest == nil {
+		t.Fatalf("unable to get latest state: %v", err)
+	}
+	if latest.Spec.State != nil {
+		t.Errorf("unexpected state %s", latest.Spec.State.Raw)
+	}
+	var secrets corev1.SecretList
+	if err := kube.List(ctx, &secrets, client.InNamespace("default")); err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets.Items) != 1 || string(secrets.Items[0].Data["password"]) != "secret" {
+		t.Fatalf("expected generated output to be stored, got %v", secrets.Items)
+	}
+	if got := secrets.Items[0].Labels[genapi.GeneratorStateLabelOutput]; got != genapi.GeneratorStateLabelOutputValue {
+		t.Errorf("expected output label, got %q", got)
+	}
+
+	// stateless generators without kept output do not get a GeneratorState
+	mgr, _ = newTestManager(t)
+	mgr.EnqueueSetLatest(ctx, "0", "default", resource, &renewingGenerator{}, nil, output, false)
+	if len(mgr.queue) != 0 {
+		t.Errorf("expected nothing to be enqueued")
+	}
+}
+
+func TestReuseLatest(t *testing.T) {
+	resource := &apiextensions.JSON{Raw: []byte(`{"kind":"Password","spec":{"length":32}}`)}
+	changed := &apiextensions.JSON{Raw: []byte(`{"kind":"Password","spec":{"length":64}}`)}
+	output := map[string][]byte{"password": []byte("secret")}
+
+	cases := map[string]struct {
+		age        time.Duration
+		resource   *apiextensions.JSON
+		policy     RegenerationPolicy
+		wantOutput map[string][]byte
+	}{
+		"Disabled": {
+			resource: resource,
+		},
+		"WithinMinInterval": {
+			age:        time.Minute,
+			resource:   resource,
+			policy:     RegenerationPolicy{MinInterval: time.Hour},
+			wantOutput: output,
+		},
+		"WithinMinIntervalSpecChanged": {
+			age:        time.Minute,
+			resource:   changed,
+			policy:     RegenerationPolicy{MinInterval: time.Hour},
+			wantOutput: output,
+		},
+		"AfterMinInterval": {
+			age:      2 * time.Hour,
+			resource: resource,
+			policy:   RegenerationPolicy{MinInterval: time.Hour},
+		},
+		"WithinMaxAge": {
+			age:        time.Minute,
+			resource:   resource,
+			policy:     RegenerationPolicy{MaxAge: time.Hour},
+			wantOutput: output,
+		},
+		"WithinMaxAgeSpecChanged": {
+			age:      time.Minute,
+			resource: changed,
+			policy:   RegenerationPolicy{MaxAge: time.Hour},
+		},
+		"AfterMaxAge": {
+			age:      2 * time.Hour,
+			resource: resource,
+			policy:   RegenerationPolicy{MaxAge: time.Hour},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mgr, _ := newTestManager(t)
+			mgr.EnqueueSetLatest(ctx, "0", "default", resource, &renewingGenerator{}, nil, output, true)
+			if err := mgr.Commit(); err != nil {
+				t.Fatalf("unable to commit: %v", err)
+			}
+			latest, err := mgr.GetLatestState("0")
+			if err != nil || latest == nil {
+				t.Fatalf("unable to get latest state: %v", err)
+			}
+			latest.CreationTimestamp = metav1.NewTime(time.Now().Add(-tc.age))
+			got, err := mgr.ReuseLatest(ctx, latest, tc.resource, tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOutput, got); diff != "" {
+				t.Errorf("unexpected output: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnqueueMoveStateToGC(t *testing.T) {
+	cases := map[string]struct {
+		retention   Retention
+		wantFlagged []string
+	}{
+		"KeepPrevious": {
+			retention:   Retention{KeepLast: 2, GCGracePeriod: time.Minute},
+			wantFlagged: []string{"state-1", "state-2"},
+		},
+		"KeepNone": {
+			retention:   Retention{KeepLast: 1, GCGracePeriod: time.Minute},
+			wantFlagged: []string{"state-1", "state-2", "state-3"},
+		},
+		"KeepAll": {
+			retention: Retention{KeepLast: 4, GCGracePeriod: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mgr, kube := newTestManager(t)
+			for i, name := range []string{"state-1", "state-2", "state-3"} {
+				state := &genapi.GeneratorState{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              name,
+						Namespace:         "default",
+						CreationTimestamp: metav1.NewTime(time.Now().Add(time.Duration(i-3) * time.Hour)),
+						Labels: map[string]string{
+							genapi.GeneratorStateLabelOwnerKey: ownerKey(mgr.resource, "0"),
+						},
+					},
+				}
+				if err := kube.Create(ctx, state); err != nil {
+					t.Fatalf("unable to create state: %v", err)
+				}
+			}
+			mgr.EnqueueMoveStateToGC("0", tc.retention)
+			if err := mgr.Commit(); err != nil {
+				t.Fatalf("unable to commit: %v", err)
+			}
+			states, err := mgr.GetAllStates("0")
+			if err != nil {
+				t.Fatalf("unable to get states: %v", err)
+			}
+			var flagged []string
+			for _, state := range states {
+				if state.Spec.GarbageCollectionDeadline == nil {
+					continue
+				}
+				flagged = append(flagged, state.Name)
+				if time.Until(state.Spec.GarbageCollectionDeadline.Time) > tc.retention.GCGracePeriod {
+					t.Errorf("unexpected gc deadline of %s: %v", state.Name, state.Spec.GarbageCollectionDeadline)
+				}
+			}
+			if diff := cmp.Diff(tc.wantFlagged, flagged); diff != "" {
+				t.Errorf("unexpected flagged states: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRetention(t *testing.T) {
+	keepLast := int32(5)
+	got := NewRetention(&esv1.GeneratorRef{
+		StateRetention: &esv1.GeneratorStateRetention{
+			KeepLast:      &keepLast,
+			GCGracePeriod: &metav1.Duration{Duration: time.Hour},
+		},
+	})
+	if diff := cmp.Diff(Retention{KeepLast: 5, GCGracePeriod: time.Hour}, got); diff != "" {
+		t.Errorf("unexpected retention: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(DefaultRetention(), NewRetention(&esv1.GeneratorRef{})); diff != "" {
+		t.Errorf("unexpected default retention: -want, +got:\n%s", diff)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	created := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	deadline := metav1.NewTime(time.Now().Truncate(time.Second))
+	states := []genapi.GeneratorState{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: deadline},
+			Status: genapi.GeneratorStateStatus{
+				Conditions: []genapi.GeneratorStateStatusCondition{
+					{Type: genapi.GeneratorStateReady, Status: corev1.ConditionTrue, Message: "GeneratorState created"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: created},
+			Spec:       genapi.GeneratorStateSpec{GarbageCollectionDeadline: &deadline},
+			Status: genapi.GeneratorStateStatus{
+				Conditions: []genapi.GeneratorStateStatusCondition{
+					{Type: genapi.GeneratorStateReady, Status: corev1.ConditionFalse, Message: "could not cleanup generator state: unavailable"},
+				},
+			},
+		},
+	}
+	want := []esv1.GeneratorStateSummary{
+		{
+			Key:                       "0",
+			Name:                      "old",
+			CreationTimestamp:         created,
+			GarbageCollectionDeadline: &deadline,
+			Ready:                     corev1.ConditionFalse,
+			Message:                   "could not cleanup generator state: unavailable",
+		},
+		{
+			Key:               "0",
+			Name:              "new",
+			CreationTimestamp: deadline,
+			Ready:             corev1.ConditionTrue,
+			Message:           "GeneratorState created",
+		},
+	}
+	if diff := cmp.Diff(want, Summarize("0", states)); diff != "" {
+		t.Errorf("unexpected summary: -want, +got:\n%s", diff)
+	}
+}
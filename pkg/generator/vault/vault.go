@@ -22,6 +22,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	vault "github.com/hashicorp/vault/api"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -41,37 +44,86 @@ import (
 type Generator struct{}
 
 const (
-	errNoSpec      = "no config spec provided"
-	errParseSpec   = "unable to parse spec: %w"
-	errVaultClient = "unable to setup Vault client: %w"
-	errGetSecret   = "unable to get dynamic secret: %w"
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errParseState    = "unable to parse state: %w"
+	errVaultClient   = "unable to setup Vault client: %w"
+	errGetSecret     = "unable to get dynamic secret: %w"
+	errRevokeLease   = "unable to revoke lease %s: %w"
+	errRevokeToken   = "unable to revoke token by accessor %s: %w"
+	errRenewLease    = "unable to renew lease %s: %w"
+	errNoProviderCfg = "no Vault provider config in spec"
+
+	pathRevokeLease    = "sys/leases/revoke"
+	pathRenewLease     = "sys/leases/renew"
+	pathRevokeAccessor = "auth/token/revoke-accessor"
 )
 
 // Generate creates dynamic credentials using HashiCorp Vault's secrets engines.
 func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
-	c := &provider.Provider{NewVaultClient: provider.NewVaultClient}
-
-	// controller-runtime/client does not support TokenRequest or other subresource APIs
-	// so we need to construct our own client and use it to fetch tokens
-	// (for Kubernetes service account token auth)
-	restCfg, err := ctrlcfg.GetConfig()
+	corev1, err := newCoreV1Client()
 	if err != nil {
 		return nil, nil, err
 	}
-	clientset, err := kubernetes.NewForConfig(restCfg)
+	return g.generate(ctx, newProvider(), jsonSpec, kube, corev1, namespace)
+}
+
+// Cleanup revokes the lease or the token of a previously generated secret.
+// Leases and tokens that do not exist anymore are considered revoked.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if previousStatus == nil {
+		return nil
+	}
+	corev1, err := newCoreV1Client()
 	if err != nil {
-		return nil, nil, err
+		return err
+	}
+	return g.cleanup(ctx, newProvider(), jsonSpec, previousStatus, kube, corev1, namespace)
+}
+
+// RenewEnabled returns true if lease renewal is configured for the generator.
+func (g *Generator) RenewEnabled(jsonSpec *apiextensions.JSON) bool {
+	if jsonSpec == nil {
+		return false
+	}
+	spec, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return false
 	}
+	return spec.Spec.RenewLease != nil
+}
 
-	return g.generate(ctx, c, jsonSpec, kube, clientset.CoreV1(), namespace)
+// Renew extends the lease of a previously generated secret.
+// It returns false if the lease is not renewable, has expired or
+// can not be extended beyond the configured minimum TTL.
+func (g *Generator) Renew(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) (genv1alpha1.GeneratorProviderState, bool, error) {
+	corev1, err := newCoreV1Client()
+	if err != nil {
+		return nil, false, err
+	}
+	return g.renew(ctx, newProvider(), jsonSpec, previousStatus, kube, corev1, namespace)
 }
 
-// Cleanup performs any necessary cleanup after token generation.
-func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
-	return nil
+func newProvider() *provider.Provider {
+	return &provider.Provider{NewVaultClient: provider.NewVaultClient}
 }
 
-func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+// controller-runtime/client does not support TokenRequest or other subresource APIs
+// so we need to construct our own client and use it to fetch tokens
+// (for Kubernetes service account token auth).
+func newCoreV1Client() (typedcorev1.CoreV1Interface, error) {
+	restCfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.CoreV1(), nil
+}
+
+func (g *Generator) newClient(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (*genv1alpha1.VaultDynamicSecret, vaultutil.Client, error) {
 	if jsonSpec == nil {
 		return nil, nil, errors.New(errNoSpec)
 	}
@@ -80,12 +132,99 @@ func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec
 		return nil, nil, fmt.Errorf(errParseSpec, err)
 	}
 	if spec == nil || spec.Spec.Provider == nil {
-		return nil, nil, errors.New("no Vault provider config in spec")
+		return nil, nil, errors.New(errNoProviderCfg)
 	}
 	cl, err := c.NewGeneratorClient(ctx, kube, corev1, spec.Spec.Provider, namespace, spec.Spec.RetrySettings)
 	if err != nil {
 		return nil, nil, fmt.Errorf(errVaultClient, err)
 	}
+	return spec, cl, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) error {
+	state, err := parseState(previousStatus)
+	if err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if state.LeaseID == "" && state.Accessor == "" {
+		return nil
+	}
+	_, cl, err := g.newClient(ctx, c, jsonSpec, kube, corev1, namespace)
+	if err != nil {
+		return err
+	}
+	if state.LeaseID != "" {
+		_, err := cl.Logical().WriteWithContext(ctx, pathRevokeLease, map[string]any{
+			"lease_id": state.LeaseID,
+		})
+		if err != nil && !isInvalidLeaseError(err) {
+			return fmt.Errorf(errRevokeLease, state.LeaseID, err)
+		}
+	}
+	if state.Accessor != "" {
+		_, err := cl.Logical().WriteWithContext(ctx, pathRevokeAccessor, map[string]any{
+			"accessor": state.Accessor,
+		})
+		if err != nil && !isInvalidLeaseError(err) {
+			return fmt.Errorf(errRevokeToken, state.Accessor, err)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) renew(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (genv1alpha1.GeneratorProviderState, bool, error) {
+	if previousStatus == nil {
+		return nil, false, nil
+	}
+	state, err := parseState(previousStatus)
+	if err != nil {
+		return nil, false, fmt.Errorf(errParseState, err)
+	}
+	// tokens are not renewed, they are re-issued instead.
+	if state.LeaseID == "" || !state.Renewable {
+		return nil, false, nil
+	}
+	spec, cl, err := g.newClient(ctx, c, jsonSpec, kube, corev1, namespace)
+	if err != nil {
+		return nil, false, err
+	}
+	renewal := spec.Spec.RenewLease
+	if renewal == nil {
+		return nil, false, nil
+	}
+	params := map[string]any{
+		"lease_id": state.LeaseID,
+	}
+	if renewal.Increment != nil {
+		params["increment"] = int(renewal.Increment.Duration.Seconds())
+	}
+	result, err := cl.Logical().WriteWithContext(ctx, pathRenewLease, params)
+	if isInvalidLeaseError(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf(errRenewLease, state.LeaseID, err)
+	}
+	if result == nil || result.LeaseDuration <= 0 {
+		return nil, false, nil
+	}
+	if renewal.MinTTL != nil && time.Duration(result.LeaseDuration)*time.Second < renewal.MinTTL.Duration {
+		return nil, false, nil
+	}
+	state.LeaseDuration = result.LeaseDuration
+	state.Renewable = result.Renewable
+	newState, err := marshalState(state)
+	if err != nil {
+		return nil, false, err
+	}
+	return newState, true, nil
+}
+
+func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	spec, cl, err := g.newClient(ctx, c, jsonSpec, kube, corev1, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	result, err := g.fetchVaultSecret(ctx, spec, cl)
 	if err != nil {
@@ -160,7 +299,55 @@ func (g *Generator) prepareResponse(res *genv1alpha1.VaultDynamicSecret, result
 			return nil, nil, err
 		}
 	}
-	return response, nil, nil
+	state, err := leaseState(result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response, state, nil
+}
+
+// leaseState returns the state of the lease and token of the response, or nil
+// if the response contains neither.
+func leaseState(result *vault.Secret) (genv1alpha1.GeneratorProviderState, error) {
+	state := &genv1alpha1.VaultDynamicSecretState{
+		LeaseID:       result.LeaseID,
+		LeaseDuration: result.LeaseDuration,
+		Renewable:     result.Renewable,
+	}
+	if result.Auth != nil {
+		state.Accessor = result.Auth.Accessor
+		if state.LeaseID == "" {
+			state.LeaseDuration = result.Auth.LeaseDuration
+		}
+	}
+	if state.LeaseID == "" && state.Accessor == "" {
+		return nil, nil
+	}
+	return marshalState(state)
+}
+
+// isInvalidLeaseError returns true if Vault rejected the request because
+// the lease or token does not exist anymore, e.g. because it expired.
+func isInvalidLeaseError(err error) bool {
+	var respErr *vault.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	if respErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	if respErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, e := range respErr.Errors {
+		e = strings.ToLower(e)
+		if strings.Contains(e, "invalid lease") ||
+			strings.Contains(e, "lease not found") ||
+			strings.Contains(e, "invalid accessor") {
+			return true
+		}
+	}
+	return false
 }
 
 func parseSpec(data []byte) (*genv1alpha1.VaultDynamicSecret, error) {
@@ -169,6 +356,20 @@ func parseSpec(data []byte) (*genv1alpha1.VaultDynamicSecret, error) {
 	return &spec, err
 }
 
+func parseState(state genv1alpha1.GeneratorProviderState) (*genv1alpha1.VaultDynamicSecretState, error) {
+	var res genv1alpha1.VaultDynamicSecretState
+	err := json.Unmarshal(state.Raw, &res)
+	return &res, err
+}
+
+func marshalState(state *genv1alpha1.VaultDynamicSecretState) (genv1alpha1.GeneratorProviderState, error) {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: raw}, nil
+}
+
 func init() {
 	genv1alpha1.Register(genv1alpha1.VaultDynamicSecretKind, &Generator{})
 }
@@ -19,6 +19,7 @@ package vaultdynamic
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -30,6 +31,7 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	utilfake "github.com/external-secrets/external-secrets/pkg/provider/util/fake"
 	provider "github.com/external-secrets/external-secrets/pkg/provider/vault"
 	"github.com/external-secrets/external-secrets/pkg/provider/vault/fake"
@@ -412,3 +414,247 @@ spec:
 		})
 	}
 }
+
+const leaseSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: VaultDynamicSecret
+spec:
+  provider:
+    auth:
+      kubernetes:
+        role: test
+        serviceAccountRef:
+          name: "testing"
+  path: "database/creds/example"
+  renewLease:
+    increment: 1h
+    minTTL: 30m`
+
+func testServiceAccount() kclient.Client {
+	return clientfake.NewClientBuilder().WithObjects(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testing",
+			Namespace: "testing",
+		},
+		Secrets: []corev1.ObjectReference{
+			{
+				Name: "test",
+			},
+		},
+	}).Build()
+}
+
+func TestVaultDynamicSecretGeneratorState(t *testing.T) {
+	cases := map[string]struct {
+		result *vaultapi.Secret
+		want   *genv1alpha1.VaultDynamicSecretState
+	}{
+		"NoLease": {
+			result: &vaultapi.Secret{Data: map[string]any{"key": "value"}},
+		},
+		"Lease": {
+			result: &vaultapi.Secret{
+				LeaseID:       "database/creds/example/abc",
+				LeaseDuration: 3600,
+				Renewable:     true,
+				Data:          map[string]any{"username": "foo"},
+			},
+			want: &genv1alpha1.VaultDynamicSecretState{
+				LeaseID:       "database/creds/example/abc",
+				LeaseDuration: 3600,
+				Renewable:     true,
+			},
+		},
+		"Token": {
+			result: &vaultapi.Secret{
+				Auth: &vaultapi.SecretAuth{
+					ClientToken:   "token",
+					Accessor:      "accessor",
+					LeaseDuration: 600,
+					Renewable:     true,
+				},
+			},
+			want: &genv1alpha1.VaultDynamicSecretState{
+				Accessor:      "accessor",
+				LeaseDuration: 600,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &provider.Provider{NewVaultClient: fake.ModifiableClientWithLoginMock(
+				func(cl *fake.VaultClient) {
+					cl.MockLogical.ReadWithDataWithContextFn = func(ctx context.Context, path string, data map[string][]string) (*vaultapi.Secret, error) {
+						return tc.result, nil
+					}
+				},
+			)}
+			gen := &Generator{}
+			_, state, err := gen.generate(context.Background(), c, &apiextensions.JSON{Raw: []byte(leaseSpec)}, testServiceAccount(), utilfake.NewCreateTokenMock().WithToken("ok"), "testing")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.want == nil {
+				if state != nil {
+					t.Errorf("expected no state, got %s", state.Raw)
+				}
+				return
+			}
+			got, err := parseState(state)
+			if err != nil {
+				t.Fatalf("unable to parse state: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected state: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVaultDynamicSecretCleanup(t *testing.T) {
+	cases := map[string]struct {
+		state     *genv1alpha1.VaultDynamicSecretState
+		writeErr  error
+		wantPaths []string
+		wantErr   bool
+	}{
+		"RevokeLease": {
+			state:     &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease"},
+			wantPaths: []string{pathRevokeLease},
+		},
+		"RevokeToken": {
+			state:     &genv1alpha1.VaultDynamicSecretState{Accessor: "accessor"},
+			wantPaths: []string{pathRevokeAccessor},
+		},
+		"LeaseAlreadyRevoked": {
+			state: &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease"},
+			writeErr: &vaultapi.ResponseError{
+				StatusCode: http.StatusBadRequest,
+				Errors:     []string{"invalid lease ID"},
+			},
+			wantPaths: []string{pathRevokeLease},
+		},
+		"RevokeFailed": {
+			state: &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease"},
+			writeErr: &vaultapi.ResponseError{
+				StatusCode: http.StatusForbidden,
+				Errors:     []string{"permission denied"},
+			},
+			wantPaths: []string{pathRevokeLease},
+			wantErr:   true,
+		},
+		"NothingToRevoke": {
+			state: &genv1alpha1.VaultDynamicSecretState{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			c := &provider.Provider{NewVaultClient: fake.ModifiableClientWithLoginMock(
+				func(cl *fake.VaultClient) {
+					cl.MockLogical.WriteWithContextFn = func(ctx context.Context, path string, data map[string]any) (*vaultapi.Secret, error) {
+						paths = append(paths, path)
+						return nil, tc.writeErr
+					}
+				},
+			)}
+			state, err := marshalState(tc.state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gen := &Generator{}
+			err = gen.cleanup(context.Background(), c, &apiextensions.JSON{Raw: []byte(leaseSpec)}, state, testServiceAccount(), utilfake.NewCreateTokenMock().WithToken("ok"), "testing")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPaths, paths); diff != "" {
+				t.Errorf("unexpected requests: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVaultDynamicSecretRenew(t *testing.T) {
+	cases := map[string]struct {
+		state       *genv1alpha1.VaultDynamicSecretState
+		result      *vaultapi.Secret
+		writeErr    error
+		wantRenewed bool
+		wantParams  map[string]any
+		wantErr     bool
+	}{
+		"Renewed": {
+			state:       &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease", LeaseDuration: 60, Renewable: true},
+			result:      &vaultapi.Secret{LeaseID: "lease", LeaseDuration: 3600, Renewable: true},
+			wantRenewed: true,
+			wantParams:  map[string]any{"lease_id": "lease", "increment": 3600},
+		},
+		"BelowMinTTL": {
+			state:      &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease", LeaseDuration: 60, Renewable: true},
+			result:     &vaultapi.Secret{LeaseID: "lease", LeaseDuration: 60, Renewable: true},
+			wantParams: map[string]any{"lease_id": "lease", "increment": 3600},
+		},
+		"NotRenewable": {
+			state: &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease", LeaseDuration: 60},
+		},
+		"Token": {
+			state: &genv1alpha1.VaultDynamicSecretState{Accessor: "accessor", LeaseDuration: 60},
+		},
+		"LeaseExpired": {
+			state: &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease", LeaseDuration: 60, Renewable: true},
+			writeErr: &vaultapi.ResponseError{
+				StatusCode: http.StatusBadRequest,
+				Errors:     []string{"lease not found"},
+			},
+			wantParams: map[string]any{"lease_id": "lease", "increment": 3600},
+		},
+		"RenewFailed": {
+			state: &genv1alpha1.VaultDynamicSecretState{LeaseID: "lease", LeaseDuration: 60, Renewable: true},
+			writeErr: &vaultapi.ResponseError{
+				StatusCode: http.StatusInternalServerError,
+			},
+			wantParams: map[string]any{"lease_id": "lease", "increment": 3600},
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var params map[string]any
+			c := &provider.Provider{NewVaultClient: fake.ModifiableClientWithLoginMock(
+				func(cl *fake.VaultClient) {
+					cl.MockLogical.WriteWithContextFn = func(ctx context.Context, path string, data map[string]any) (*vaultapi.Secret, error) {
+						if path != pathRenewLease {
+							t.Errorf("unexpected path %s", path)
+						}
+						params = data
+						return tc.result, tc.writeErr
+					}
+				},
+			)}
+			state, err := marshalState(tc.state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gen := &Generator{}
+			newState, renewed, err := gen.renew(context.Background(), c, &apiextensions.JSON{Raw: []byte(leaseSpec)}, state, testServiceAccount(), utilfake.NewCreateTokenMock().WithToken("ok"), "testing")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if renewed != tc.wantRenewed {
+				t.Errorf("expected renewed to be %v", tc.wantRenewed)
+			}
+			if diff := cmp.Diff(tc.wantParams, params); diff != "" {
+				t.Errorf("unexpected request: -want, +got:\n%s", diff)
+			}
+			if !renewed {
+				return
+			}
+			got, err := parseState(newState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.LeaseDuration != tc.result.LeaseDuration {
+				t.Errorf("expected lease duration %d, got %d", tc.result.LeaseDuration, got.LeaseDuration)
+			}
+		})
+	}
+}
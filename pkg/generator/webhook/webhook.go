@@ -20,15 +20,22 @@ package webhook
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/PaesslerAG/jsonpath"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	"github.com/external-secrets/external-secrets/pkg/common/webhook"
 )
 
+// stateTemplateKey is the name under which captured values are passed to the cleanup templates.
+const stateTemplateKey = "state"
+
 // Webhook represents a generator that calls external webhooks to generate secrets.
 type Webhook struct {
 	wh  webhook.Webhook
@@ -51,15 +58,117 @@ func (w *Webhook) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kc
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to prepare provider http client: %w", err)
 	}
-	data, err := w.wh.GetSecretMap(ctx, provider, nil)
-	return data, nil, err
+	result, err := w.wh.GetWebhookData(ctx, provider, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := webhook.ParseSecretMap(provider, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup, err := parseCleanup(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cleanup spec: %w", err)
+	}
+	state, err := captureState(cleanup, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, state, nil
 }
 
-// Cleanup performs any necessary cleanup operations after secret generation.
-func (w *Webhook) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+// Cleanup sends the cleanup request of the generator, if one is configured.
+// A not found response is considered a successful cleanup.
+func (w *Webhook) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousStatus genv1alpha1.GeneratorProviderState, kclient client.Client, ns string) error {
+	if previousStatus == nil {
+		return nil
+	}
+	cleanup, err := parseCleanup(jsonSpec.Raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse cleanup spec: %w", err)
+	}
+	if cleanup == nil {
+		return nil
+	}
+	var state genv1alpha1.WebhookState
+	if err := json.Unmarshal(previousStatus.Raw, &state); err != nil {
+		return fmt.Errorf("failed to parse state: %w", err)
+	}
+	provider, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse provider spec: %w", err)
+	}
+	provider.Method = cleanup.Method
+	if provider.Method == "" {
+		provider.Method = http.MethodDelete
+	}
+	provider.URL = cleanup.URL
+	provider.Body = cleanup.Body
+	if cleanup.Headers != nil {
+		provider.Headers = cleanup.Headers
+	}
+	wh := webhook.Webhook{
+		Namespace: ns,
+		Kube:      kclient,
+	}
+	wh.HTTP, err = wh.GetHTTPClient(ctx, provider)
+	if err != nil {
+		return fmt.Errorf("failed to prepare provider http client: %w", err)
+	}
+	_, err = wh.SendRequest(ctx, provider, map[string]map[string]string{
+		stateTemplateKey: state.Values,
+	})
+	if err != nil && !errors.Is(err, esv1.NoSecretErr) {
+		return fmt.Errorf("failed to send cleanup request: %w", err)
+	}
 	return nil
 }
 
+// captureState extracts the values referenced by the cleanup spec from the response.
+// It returns nil if no cleanup is configured.
+func captureState(cleanup *genv1alpha1.WebhookCleanup, result []byte) (genv1alpha1.GeneratorProviderState, error) {
+	if cleanup == nil {
+		return nil, nil
+	}
+	state := genv1alpha1.WebhookState{
+		Values: make(map[string]string, len(cleanup.Values)),
+	}
+	if len(cleanup.Values) > 0 {
+		var jsondata any
+		if err := json.Unmarshal(result, &jsondata); err != nil {
+			return nil, fmt.Errorf("failed to parse response json: %w", err)
+		}
+		for name, path := range cleanup.Values {
+			val, err := jsonpath.Get(path, jsondata)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cleanup value %s from response path %s: %w", name, path, err)
+			}
+			if str, ok := val.(string); ok {
+				state.Values[name] = str
+				continue
+			}
+			raw, err := json.Marshal(val)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal cleanup value %s: %w", name, err)
+			}
+			state.Values[name] = string(raw)
+		}
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: raw}, nil
+}
+
+func parseCleanup(data []byte) (*genv1alpha1.WebhookCleanup, error) {
+	var spec genv1alpha1.Webhook
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	return spec.Spec.Cleanup, nil
+}
+
 func parseSpec(data []byte) (*webhook.Spec, error) {
 	var spec genv1alpha1.Webhook
 	err := json.Unmarshal(data, &spec)
@@ -266,3 +266,92 @@ func makeGenerator(url string, args args) *genv1alpha1.Webhook {
 	}
 	return store
 }
+
+func TestWebhookCleanup(t *testing.T) {
+	var gotMethod, gotPath, gotBody string
+	cleanupStatus := http.StatusNoContent
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/token" {
+			rw.Write([]byte(`{"id":"tok 1","expiry":3600,"result":{"token":"secret-value"}}`))
+			return
+		}
+		gotMethod = req.Method
+		gotPath = req.URL.String()
+		body, _ := io.ReadAll(req.Body)
+		gotBody = string(body)
+		rw.WriteHeader(cleanupStatus)
+	}))
+	defer ts.Close()
+
+	gen := makeGenerator(ts.URL, args{URL: "/api/token", JSONPath: "$.result"})
+	gen.Spec.Cleanup = &genv1alpha1.WebhookCleanup{
+		URL:  ts.URL + "/api/revoke?id={{ .state.id }}",
+		Body: `{"id":"{{ .state.id }}","expiry":{{ .state.expiry }}}`,
+		Values: map[string]string{
+			"id":     "$.id",
+			"expiry": "$.expiry",
+		},
+	}
+	raw, err := json.Marshal(gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &apiextensions.JSON{Raw: raw}
+
+	w := &Webhook{}
+	data, state, err := w.Generate(context.Background(), spec, nil, "testnamespace")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data["token"]) != "secret-value" {
+		t.Errorf("unexpected token: %s", data["token"])
+	}
+	if state == nil {
+		t.Fatal("expected state to be returned")
+	}
+	if want := `{"values":{"expiry":"3600","id":"tok 1"}}`; string(state.Raw) != want {
+		t.Errorf("unexpected state: %s (expected %s)", state.Raw, want)
+	}
+
+	if err := w.Cleanup(context.Background(), spec, state, nil, "testnamespace"); err != nil {
+		t.Fatalf("unexpected cleanup error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("unexpected cleanup method: %s", gotMethod)
+	}
+	if gotPath != "/api/revoke?id=tok+1" {
+		t.Errorf("unexpected cleanup path: %s", gotPath)
+	}
+	if gotBody != `{"id":"tok 1","expiry":3600}` {
+		t.Errorf("unexpected cleanup body: %s", gotBody)
+	}
+
+	cleanupStatus = http.StatusNotFound
+	if err := w.Cleanup(context.Background(), spec, state, nil, "testnamespace"); err != nil {
+		t.Errorf("expected not found to be ignored, got: %v", err)
+	}
+
+	cleanupStatus = http.StatusInternalServerError
+	if err := w.Cleanup(context.Background(), spec, state, nil, "testnamespace"); err == nil {
+		t.Error("expected cleanup to fail")
+	}
+}
+
+func TestWebhookWithoutCleanupHasNoState(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Write([]byte(`{"token":"secret-value"}`))
+	}))
+	defer ts.Close()
+
+	raw, err := json.Marshal(makeGenerator(ts.URL, args{URL: "/api/token"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, state, err := (&Webhook{}).Generate(context.Background(), &apiextensions.JSON{Raw: raw}, nil, "testnamespace")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state != nil {
+		t.Errorf("expected no state, got %s", state.Raw)
+	}
+}
@@ -28,7 +28,14 @@ const (
 	// ExternalSecretSubsystem is the subsystem name used for external secret metrics.
 	ExternalSecretSubsystem = "externalsecret"
 
-	providerAPICalls = "provider_api_calls_count"
+	// SecretStoreSubsystem is the subsystem name used for secret store metrics.
+	SecretStoreSubsystem = "secretstore"
+
+	providerAPICalls       = "provider_api_calls_count"
+	storeRequestQueueDepth = "request_queue_depth"
+	storeRequestsThrottled = "requests_throttled_count"
+	storeCircuitBreaker    = "circuit_breaker_state"
+	storeRequestsRejected  = "requests_rejected_count"
 )
 
 var (
@@ -37,6 +44,30 @@ var (
 		Name:      providerAPICalls,
 		Help:      "Number of API calls towards the secret provider",
 	}, []string{"provider", "call", "status"})
+
+	storeRequestQueueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: SecretStoreSubsystem,
+		Name:      storeRequestQueueDepth,
+		Help:      "Number of requests waiting for the rate limit of the secret store",
+	}, []string{"kind", "namespace", "name"})
+
+	storeRequestsThrottledCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: SecretStoreSubsystem,
+		Name:      storeRequestsThrottled,
+		Help:      "Number of requests which had to wait for the rate limit of the secret store",
+	}, []string{"kind", "namespace", "name"})
+
+	storeCircuitBreakerGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: SecretStoreSubsystem,
+		Name:      storeCircuitBreaker,
+		Help:      "The state of the circuit breaker of the secret store: 0 closed, 1 half-open, 2 open",
+	}, []string{"kind", "namespace", "name"})
+
+	storeRequestsRejectedCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: SecretStoreSubsystem,
+		Name:      storeRequestsRejected,
+		Help:      "Number of requests which were rejected by the open circuit breaker of the secret store",
+	}, []string{"kind", "namespace", "name"})
 )
 
 // ObserveAPICall records metrics for an API call to a provider.
@@ -44,6 +75,32 @@ func ObserveAPICall(provider, call string, err error) {
 	syncCallsTotal.WithLabelValues(provider, call, deriveStatus(err)).Inc()
 }
 
+// ObserveThrottledStoreRequest records a request which has to wait for the rate limit of a store.
+// The returned func must be called once the request stops waiting.
+func ObserveThrottledStoreRequest(kind, namespace, name string) func() {
+	storeRequestsThrottledCount.WithLabelValues(kind, namespace, name).Inc()
+	queueDepth := storeRequestQueueDepthGauge.WithLabelValues(kind, namespace, name)
+	queueDepth.Inc()
+	return queueDepth.Dec
+}
+
+// SetStoreCircuitBreakerState records the state of the circuit breaker of a store,
+// 0 is closed, 1 is half-open and 2 is open.
+func SetStoreCircuitBreakerState(kind, namespace, name string, state float64) {
+	storeCircuitBreakerGauge.WithLabelValues(kind, namespace, name).Set(state)
+}
+
+// RemoveStoreCircuitBreaker removes the circuit breaker metrics of a deleted store.
+func RemoveStoreCircuitBreaker(kind, namespace, name string) {
+	storeCircuitBreakerGauge.DeleteLabelValues(kind, namespace, name)
+	storeRequestsRejectedCount.DeleteLabelValues(kind, namespace, name)
+}
+
+// ObserveRejectedStoreRequest records a request which was rejected by the open circuit breaker of a store.
+func ObserveRejectedStoreRequest(kind, namespace, name string) {
+	storeRequestsRejectedCount.WithLabelValues(kind, namespace, name).Inc()
+}
+
 func deriveStatus(err error) string {
 	if err != nil {
 		return constants.StatusError
@@ -52,5 +109,6 @@ func deriveStatus(err error) string {
 }
 
 func init() {
-	metrics.Registry.MustRegister(syncCallsTotal)
+	metrics.Registry.MustRegister(syncCallsTotal, storeRequestQueueDepthGauge, storeRequestsThrottledCount,
+		storeCircuitBreakerGauge, storeRequestsRejectedCount)
 }
@@ -43,6 +43,7 @@ import (
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
+	"github.com/external-secrets/external-secrets/pkg/esutils/metadata"
 	"github.com/external-secrets/external-secrets/pkg/find"
 )
 
@@ -52,6 +53,7 @@ type Ctx string
 const (
 	defaultAPIUrl           = "https://api.akeyless.io"
 	extSecretManagedTag     = "k8s-external-secrets"
+	staticSecretType        = "STATIC_SECRET"
 	aKeylessToken       Ctx = "AKEYLESS_TOKEN"
 )
 
@@ -87,13 +89,33 @@ type Item struct {
 	LastVersion int32  `json:"last_version"`
 }
 
+// PushSecretMetadataSpec contains the optional settings that can be applied to a pushed static secret
+// through the PushSecret metadata.
+type PushSecretMetadataSpec struct {
+	// Description of the static secret.
+	Description string `json:"description,omitempty"`
+	// Tags are added to the static secret in addition to the managed tag.
+	// Existing tags are never removed.
+	Tags []string `json:"tags,omitempty"`
+	// SecretPushFormat is the format of the value of the static secret, json (default) or plain.
+	// With plain the value of the secretKey is pushed as is, it requires a secretKey and no property.
+	SecretPushFormat string `json:"secretPushFormat,omitempty"`
+}
+
+// Formats of the value of a pushed static secret.
+const (
+	SecretPushFormatJSON  = "json"
+	SecretPushFormatPlain = "plain"
+)
+
 type akeylessVaultInterface interface {
 	GetSecretByType(ctx context.Context, secretName string, version int32) (string, error)
 	TokenFromSecretRef(ctx context.Context) (string, error)
 	ListSecrets(ctx context.Context, path, tag string) ([]string, error)
 	DescribeItem(ctx context.Context, itemName string) (*akeyless.Item, error)
-	CreateSecret(ctx context.Context, remoteKey, data string) error
+	CreateSecret(ctx context.Context, remoteKey, data, description string, tags []string) error
 	UpdateSecret(ctx context.Context, remoteKey, data string) error
+	UpdateItem(ctx context.Context, remoteKey, description string, addTags []string) error
 	DeleteSecret(ctx context.Context, remoteKey string) error
 }
 
@@ -105,7 +127,7 @@ func init() {
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
 func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
-	return esv1.SecretStoreReadOnly
+	return esv1.SecretStoreReadWrite
 }
 
 // NewClient constructs a new secrets client based on the provided store.
@@ -452,30 +474,73 @@ func initMapIfNotExist(psd esv1.PushSecretData, secretMapSize int) map[string]an
 	return make(map[string]any, mapSize)
 }
 
-// PushSecret pushes a Kubernetes secret to Akeyless Vault using the provided data.
+// PushSecret pushes a Kubernetes secret to an Akeyless static secret using the provided data.
+// The static secret is created if it does not exist, otherwise its value is updated.
+// The value is stored as a JSON object, unless the plain secretPushFormat is set in the metadata.
 func (a *Akeyless) PushSecret(ctx context.Context, secret *corev1.Secret, psd esv1.PushSecretData) error {
 	if esutils.IsNil(a.Client) {
 		return errors.New(errUninitalizedAkeylessProvider)
 	}
-	ctx, err := a.contextWithToken(ctx)
+	meta, err := metadata.ParseMetadataParameters[PushSecretMetadataSpec](psd.GetMetadata())
+	if err != nil {
+		return fmt.Errorf(errInvalidPushMetadata, err)
+	}
+	if meta == nil {
+		meta = &metadata.PushSecretMetadata[PushSecretMetadataSpec]{}
+	}
+	ctx, err = a.contextWithToken(ctx)
 	if err != nil {
 		return err
 	}
-	secretRemote, err := a.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{Key: psd.GetRemoteKey()})
+	item, err := a.Client.DescribeItem(ctx, psd.GetRemoteKey())
 	isNotExists := errors.Is(err, ErrItemNotExists)
 	if err != nil && !isNotExists {
 		return err
 	}
-	var data map[string]any
-	if isNotExists {
-		data = initMapIfNotExist(psd, len(secret.Data))
-		err = nil
-	} else {
-		err = json.Unmarshal(secretRemote, &data)
+	var secretRemote []byte
+	if !isNotExists {
+		if item != nil && item.GetItemType() != "" && item.GetItemType() != staticSecretType {
+			return fmt.Errorf(errPushNonStaticSecret, psd.GetRemoteKey(), item.GetItemType())
+		}
+		secretRemote, err = a.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{Key: psd.GetRemoteKey()})
+		isNotExists = errors.Is(err, ErrItemNotExists)
+		if err != nil && !isNotExists {
+			return err
+		}
 	}
+	value, err := pushValue(secret, psd, secretRemote, isNotExists, meta.Spec.SecretPushFormat)
 	if err != nil {
 		return err
 	}
+	if isNotExists {
+		return a.Client.CreateSecret(ctx, psd.GetRemoteKey(), string(value), meta.Spec.Description, meta.Spec.Tags)
+	}
+	if !bytes.Equal(value, secretRemote) {
+		if err := a.Client.UpdateSecret(ctx, psd.GetRemoteKey(), string(value)); err != nil {
+			return err
+		}
+	}
+	return a.updateItemMetadata(ctx, psd.GetRemoteKey(), item, meta.Spec)
+}
+
+// pushValue computes the value that is written to the static secret.
+func pushValue(secret *corev1.Secret, psd esv1.PushSecretData, secretRemote []byte, isNotExists bool, format string) ([]byte, error) {
+	switch format {
+	case "", SecretPushFormatJSON:
+	case SecretPushFormatPlain:
+		if psd.GetSecretKey() == "" || psd.GetProperty() != "" {
+			return nil, fmt.Errorf(errPlainPushFormat, format)
+		}
+		return esutils.ExtractSecretData(psd, secret)
+	default:
+		return nil, fmt.Errorf(errInvalidPushFormat, format, SecretPushFormatJSON, SecretPushFormatPlain)
+	}
+	var data map[string]any
+	if isNotExists {
+		data = initMapIfNotExist(psd, len(secret.Data))
+	} else if err := json.Unmarshal(secretRemote, &data); err != nil {
+		return nil, err
+	}
 	if psd.GetProperty() == "" {
 		for k, v := range secret.Data {
 			data[k] = string(v)
@@ -483,17 +548,28 @@ func (a *Akeyless) PushSecret(ctx context.Context, secret *corev1.Secret, psd es
 	} else if v, ok := secret.Data[psd.GetSecretKey()]; ok {
 		data[psd.GetProperty()] = string(v)
 	}
-	dataByte, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-	if bytes.Equal(dataByte, secretRemote) {
+	return json.Marshal(data)
+}
+
+// updateItemMetadata sets the description and adds the missing tags of an existing static secret.
+func (a *Akeyless) updateItemMetadata(ctx context.Context, remoteKey string, item *akeyless.Item, meta PushSecretMetadataSpec) error {
+	if item == nil {
 		return nil
 	}
-	if isNotExists {
-		return a.Client.CreateSecret(ctx, psd.GetRemoteKey(), string(dataByte))
+	description := ""
+	if meta.Description != "" && meta.Description != item.GetItemMetadata() {
+		description = meta.Description
+	}
+	var addTags []string
+	for _, tag := range meta.Tags {
+		if !slices.Contains(item.GetItemTags(), tag) && !slices.Contains(addTags, tag) {
+			addTags = append(addTags, tag)
+		}
 	}
-	return a.Client.UpdateSecret(ctx, psd.GetRemoteKey(), string(dataByte))
+	if description == "" && len(addTags) == 0 {
+		return nil
+	}
+	return a.Client.UpdateItem(ctx, remoteKey, description, addTags)
 }
 
 // DeleteSecret deletes a secret from Akeyless Vault at the specified remote reference.
@@ -506,6 +582,9 @@ func (a *Akeyless) DeleteSecret(ctx context.Context, psr esv1.PushSecretRemoteRe
 		return err
 	}
 	item, err := a.Client.DescribeItem(ctx, psr.GetRemoteKey())
+	if errors.Is(err, ErrItemNotExists) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
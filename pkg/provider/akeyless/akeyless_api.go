@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 
@@ -148,6 +149,10 @@ func (a *akeylessBase) DescribeItem(ctx context.Context, itemName string) (*akey
 	}
 	gsvOut, res, err := a.RestAPI.DescribeItem(ctx).Body(body).Execute()
 	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDescribeItem, err)
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		_ = res.Body.Close()
+		return nil, ErrItemNotExists
+	}
 	if errors.As(err, &apiErr) {
 		var item *Item
 		err = json.Unmarshal(apiErr.Body(), &item)
@@ -346,20 +351,29 @@ func (a *akeylessBase) ListSecrets(ctx context.Context, path, tag string) ([]str
 	return listNames, nil
 }
 
-func (a *akeylessBase) CreateSecret(ctx context.Context, remoteKey, data string) error {
+func (a *akeylessBase) CreateSecret(ctx context.Context, remoteKey, data, description string, tags []string) error {
 	body := akeyless.CreateSecret{
 		Name:  remoteKey,
 		Value: data,
 		Tags:  &[]string{extSecretManagedTag},
 	}
+	if description != "" {
+		body.Description = akeyless.PtrString(description)
+	}
+	for _, tag := range tags {
+		if tag != extSecretManagedTag {
+			*body.Tags = append(*body.Tags, tag)
+		}
+	}
 	if err := SetBodyToken(ctx, &body); err != nil {
 		return err
 	}
 	_, res, err := a.RestAPI.CreateSecret(ctx).Body(body).Execute()
-	defer func() {
-		_ = res.Body.Close()
-	}()
 	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMCreateSecret, err)
+	closeResponse(res)
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf("can't create secret: %v", string(apiErr.Body()))
+	}
 	return err
 }
 
@@ -372,10 +386,33 @@ func (a *akeylessBase) UpdateSecret(ctx context.Context, remoteKey, data string)
 		return err
 	}
 	_, res, err := a.RestAPI.UpdateSecretVal(ctx).Body(body).Execute()
-	defer func() {
-		_ = res.Body.Close()
-	}()
 	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateSecretVal, err)
+	closeResponse(res)
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf("can't update secret value: %v", string(apiErr.Body()))
+	}
+	return err
+}
+
+func (a *akeylessBase) UpdateItem(ctx context.Context, remoteKey, description string, addTags []string) error {
+	body := akeyless.UpdateItem{
+		Name: remoteKey,
+	}
+	if description != "" {
+		body.Description = akeyless.PtrString(description)
+	}
+	if len(addTags) > 0 {
+		body.AddTag = &addTags
+	}
+	if err := SetBodyToken(ctx, &body); err != nil {
+		return err
+	}
+	_, res, err := a.RestAPI.UpdateItem(ctx).Body(body).Execute()
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateItem, err)
+	closeResponse(res)
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf("can't update item: %v", string(apiErr.Body()))
+	}
 	return err
 }
 
@@ -387,13 +424,18 @@ func (a *akeylessBase) DeleteSecret(ctx context.Context, remoteKey string) error
 		return err
 	}
 	_, res, err := a.RestAPI.DeleteItem(ctx).Body(body).Execute()
-	defer func() {
-		_ = res.Body.Close()
-	}()
 	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDeleteItem, err)
+	closeResponse(res)
 	return err
 }
 
+// closeResponse closes the response body, the response is nil if the request could not be sent.
+func closeResponse(res *http.Response) {
+	if res != nil && res.Body != nil {
+		_ = res.Body.Close()
+	}
+}
+
 func (a *akeylessBase) getK8SServiceAccountJWT(ctx context.Context, kubernetesAuth *esv1.AkeylessKubernetesAuth) (string, error) {
 	if kubernetesAuth == nil {
 		return readK8SServiceAccountJWT()
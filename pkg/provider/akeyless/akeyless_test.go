@@ -26,6 +26,7 @@ import (
 	"github.com/akeylesslabs/akeyless-go/v4"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
@@ -343,7 +344,7 @@ func TestPushSecret(t *testing.T) {
 			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "morgoth", nil })),
 		makeValidAkeylessTestCase("create new secret").SetExpectInput(&corev1.Secret{Data: map[string][]byte{"test": []byte("test")}}).
 			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "", ErrItemNotExists }).
-				SetCreateSecretFn(func(_ context.Context, _ string, data string) error {
+				SetCreateSecretFn(func(_ context.Context, _, data, _ string, _ []string) error {
 					if data != `{"test":"test"}` {
 						return errors.New("secret is not good")
 					}
@@ -369,6 +370,67 @@ func TestPushSecret(t *testing.T) {
 					}
 					return nil
 				})),
+		makeValidAkeylessTestCase("create plain secret with metadata").SetExpectInput(&corev1.Secret{Data: map[string][]byte{"api-key": []byte("s3cr3t")}}).
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", RemoteKey: "/k8s/api-key", Metadata: pushMetadata(t, `{"description":"generated","tags":["team-a"],"secretPushFormat":"plain"}`)}).
+			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, _ string) (*akeyless.Item, error) { return nil, ErrItemNotExists }).
+				SetCreateSecretFn(func(_ context.Context, remoteKey, data, description string, tags []string) error {
+					if remoteKey != "/k8s/api-key" || data != "s3cr3t" {
+						return fmt.Errorf("unexpected secret %s=%s", remoteKey, data)
+					}
+					if description != "generated" || len(tags) != 1 || tags[0] != "team-a" {
+						return fmt.Errorf("unexpected metadata %s %v", description, tags)
+					}
+					return nil
+				})),
+		makeValidAkeylessTestCase("update plain secret and add tags").SetExpectInput(&corev1.Secret{Data: map[string][]byte{"api-key": []byte("new")}}).
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", Metadata: pushMetadata(t, `{"description":"generated","tags":["team-a","team-b"],"secretPushFormat":"plain"}`)}).
+			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, itemName string) (*akeyless.Item, error) {
+				return &akeyless.Item{
+					ItemName:     &itemName,
+					ItemType:     akeyless.PtrString(staticSecretType),
+					ItemMetadata: akeyless.PtrString("generated"),
+					ItemTags:     &[]string{extSecretManagedTag, "team-a"},
+				}, nil
+			}).SetGetSecretFn(func(_ string, _ int32) (string, error) { return "old", nil }).
+				SetUpdateSecretFn(func(_ context.Context, _, data string) error {
+					if data != "new" {
+						return fmt.Errorf("secret %s expected %s", data, "new")
+					}
+					return nil
+				}).
+				SetUpdateItemFn(func(_ context.Context, _, description string, addTags []string) error {
+					if description != "" || len(addTags) != 1 || addTags[0] != "team-b" {
+						return fmt.Errorf("unexpected metadata update %q %v", description, addTags)
+					}
+					return nil
+				})),
+		makeValidAkeylessTestCase("unchanged plain secret").SetExpectInput(&corev1.Secret{Data: map[string][]byte{"api-key": []byte("same")}}).
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", Metadata: pushMetadata(t, `{"secretPushFormat":"plain"}`)}).
+			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "same", nil })),
+		makeValidAkeylessTestCase("missing secret key").SetExpectErr("failed to find secret key").
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", Metadata: pushMetadata(t, `{"secretPushFormat":"plain"}`)}).
+			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "same", nil })),
+		makeValidAkeylessTestCase("secret key without property is pushed as json by default").SetExpectInput(&corev1.Secret{Data: map[string][]byte{"api-key": []byte("s3cr3t")}}).
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key"}).
+			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return `{"api-key":"old"}`, nil }).
+				SetUpdateSecretFn(func(_ context.Context, _, data string) error {
+					if data != `{"api-key":"s3cr3t"}` {
+						return fmt.Errorf("secret %s expected %s", data, `{"api-key":"s3cr3t"}`)
+					}
+					return nil
+				})),
+		makeValidAkeylessTestCase("plain format with property").SetExpectErr("requires a secretKey and no property").
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", Property: "prop", Metadata: pushMetadata(t, `{"secretPushFormat":"plain"}`)}).
+			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "{}", nil })),
+		makeValidAkeylessTestCase("invalid format").SetExpectErr("invalid secretPushFormat").
+			SetExpectInput2(&testingfake.PushSecretData{SecretKey: "api-key", Metadata: pushMetadata(t, `{"secretPushFormat":"yaml"}`)}).
+			SetMockClient(fakeakeyless.New().SetGetSecretFn(func(_ string, _ int32) (string, error) { return "{}", nil })),
+		makeValidAkeylessTestCase("refuse non static secret").SetExpectErr("only static secrets are supported").
+			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, itemName string) (*akeyless.Item, error) {
+				return &akeyless.Item{ItemName: &itemName, ItemType: akeyless.PtrString("ROTATED_SECRET")}, nil
+			})),
+		makeValidAkeylessTestCase("invalid metadata").SetExpectErr("unable to parse push secret metadata").
+			SetExpectInput2(&testingfake.PushSecretData{Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"kind":"Unknown"}`)}}),
 	}
 
 	sm := Akeyless{}
@@ -388,6 +450,12 @@ func TestPushSecret(t *testing.T) {
 	}
 }
 
+func pushMetadata(t *testing.T, spec string) *apiextensionsv1.JSON {
+	t.Helper()
+	raw := fmt.Sprintf(`{"apiVersion":"kubernetes.external-secrets.io/v1alpha1","kind":"PushSecretMetadata","spec":%s}`, spec)
+	return &apiextensionsv1.JSON{Raw: []byte(raw)}
+}
+
 func TestDeleteSecret(t *testing.T) {
 	testCases := []*akeylessTestCase{
 		nilProviderTestCase(),
@@ -395,6 +463,8 @@ func TestDeleteSecret(t *testing.T) {
 			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, _ string) (*akeyless.Item, error) { return nil, errors.New("err desc") })),
 		makeValidAkeylessTestCase("no such item").
 			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, _ string) (*akeyless.Item, error) { return nil, nil })),
+		makeValidAkeylessTestCase("item does not exist").
+			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, _ string) (*akeyless.Item, error) { return nil, ErrItemNotExists })),
 		makeValidAkeylessTestCase("tags nil").
 			SetMockClient(fakeakeyless.New().SetDescribeItemFn(func(_ context.Context, _ string) (*akeyless.Item, error) { return &akeyless.Item{}, nil })),
 		makeValidAkeylessTestCase("no external secret managed tags").
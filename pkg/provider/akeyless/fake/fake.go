@@ -26,8 +26,9 @@ import (
 // AkeylessMockClient implements a mock client for Akeyless API operations.
 type AkeylessMockClient struct {
 	getSecret    func(secretName string, version int32) (string, error)
-	createSecret func(ctx context.Context, remoteKey, data string) error
+	createSecret func(ctx context.Context, remoteKey, data, description string, tags []string) error
 	updateSecret func(ctx context.Context, remoteKey, data string) error
+	updateItem   func(ctx context.Context, remoteKey, description string, addTags []string) error
 	deleteSecret func(ctx context.Context, remoteKey string) error
 	describeItem func(ctx context.Context, itemName string) (*akeyless.Item, error)
 }
@@ -44,7 +45,7 @@ func (mc *AkeylessMockClient) SetGetSecretFn(f func(secretName string, version i
 }
 
 // SetCreateSecretFn sets the function to be called when CreateSecret is invoked.
-func (mc *AkeylessMockClient) SetCreateSecretFn(f func(ctx context.Context, remoteKey, data string) error) *AkeylessMockClient {
+func (mc *AkeylessMockClient) SetCreateSecretFn(f func(ctx context.Context, remoteKey, data, description string, tags []string) error) *AkeylessMockClient {
 	mc.createSecret = f
 	return mc
 }
@@ -55,6 +56,12 @@ func (mc *AkeylessMockClient) SetUpdateSecretFn(f func(ctx context.Context, remo
 	return mc
 }
 
+// SetUpdateItemFn sets the function to be called when UpdateItem is invoked.
+func (mc *AkeylessMockClient) SetUpdateItemFn(f func(ctx context.Context, remoteKey, description string, addTags []string) error) *AkeylessMockClient {
+	mc.updateItem = f
+	return mc
+}
+
 // SetDeleteSecretFn sets the function to be called when DeleteSecret is invoked.
 func (mc *AkeylessMockClient) SetDeleteSecretFn(f func(ctx context.Context, remoteKey string) error) *AkeylessMockClient {
 	mc.deleteSecret = f
@@ -68,8 +75,8 @@ func (mc *AkeylessMockClient) SetDescribeItemFn(f func(ctx context.Context, item
 }
 
 // CreateSecret creates a new secret in the mock Akeyless client.
-func (mc *AkeylessMockClient) CreateSecret(ctx context.Context, remoteKey, data string) error {
-	return mc.createSecret(ctx, remoteKey, data)
+func (mc *AkeylessMockClient) CreateSecret(ctx context.Context, remoteKey, data, description string, tags []string) error {
+	return mc.createSecret(ctx, remoteKey, data, description, tags)
 }
 
 // DeleteSecret deletes a secret from the mock Akeyless client.
@@ -78,7 +85,11 @@ func (mc *AkeylessMockClient) DeleteSecret(ctx context.Context, remoteKey string
 }
 
 // DescribeItem retrieves an item description from the mock Akeyless client.
+// Without a configured function every item is described as a static secret.
 func (mc *AkeylessMockClient) DescribeItem(ctx context.Context, itemName string) (*akeyless.Item, error) {
+	if mc.describeItem == nil {
+		return &akeyless.Item{ItemName: akeyless.PtrString(itemName), ItemType: akeyless.PtrString("STATIC_SECRET")}, nil
+	}
 	return mc.describeItem(ctx, itemName)
 }
 
@@ -87,6 +98,11 @@ func (mc *AkeylessMockClient) UpdateSecret(ctx context.Context, remoteKey, data
 	return mc.updateSecret(ctx, remoteKey, data)
 }
 
+// UpdateItem updates the description and tags of an item in the mock Akeyless client.
+func (mc *AkeylessMockClient) UpdateItem(ctx context.Context, remoteKey, description string, addTags []string) error {
+	return mc.updateItem(ctx, remoteKey, description, addTags)
+}
+
 // TokenFromSecretRef returns a new token for the mock Akeyless client.
 func (mc *AkeylessMockClient) TokenFromSecretRef(_ context.Context) (string, error) {
 	return "newToken", nil
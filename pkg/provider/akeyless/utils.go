@@ -45,6 +45,10 @@ const (
 	errGetKubeSANoToken             = "cannot find token in secrets bound to service account: %q"
 	errGetKubeSATokenRequest        = "cannot request Kubernetes service account token for service account %q: %w"
 	errInvalidKubeSA                = "invalid Auth.Kubernetes.ServiceAccountRef: %w"
+	errInvalidPushMetadata          = "unable to parse push secret metadata: %w"
+	errPushNonStaticSecret          = "cannot push to item %q of type %s: only static secrets are supported"
+	errInvalidPushFormat            = "invalid secretPushFormat %q: must be %q or %q"
+	errPlainPushFormat              = "secretPushFormat %q requires a secretKey and no property"
 )
 
 // GetAKeylessProvider does the necessary nil checks and returns the akeyless provider or an error.
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"runtime"
@@ -47,6 +48,26 @@ type SecretsManagerClient interface {
 		ctx context.Context,
 		request *kms.GetSecretValueRequest,
 	) (*kms.GetSecretValueResponseBody, error)
+	ListSecrets(
+		ctx context.Context,
+		request *kms.ListSecretsRequest,
+	) (*kms.ListSecretsResponseBody, error)
+	DescribeSecret(
+		ctx context.Context,
+		request *kms.DescribeSecretRequest,
+	) (*kms.DescribeSecretResponseBody, error)
+	CreateSecret(
+		ctx context.Context,
+		request *kms.CreateSecretRequest,
+	) (*kms.CreateSecretResponseBody, error)
+	PutSecretValue(
+		ctx context.Context,
+		request *kms.PutSecretValueRequest,
+	) (*kms.PutSecretValueResponseBody, error)
+	DeleteSecret(
+		ctx context.Context,
+		request *kms.DeleteSecretRequest,
+	) (*kms.DeleteSecretResponseBody, error)
 	Endpoint() string
 }
 
@@ -109,7 +130,7 @@ func (s *secretsManagerClient) GetSecretValue(
 	ctx context.Context,
 	request *kms.GetSecretValueRequest,
 ) (*kms.GetSecretValueResponseBody, error) {
-	resp, err := s.doAPICall(ctx, "GetSecretValue", request)
+	resp, err := s.doAPICall(ctx, "GetSecretValue", methodTypeGET, request)
 	if err != nil {
 		return nil, fmt.Errorf("error getting secret [%s] latest value: %w", esutils.Deref(request.SecretName), err)
 	}
@@ -122,22 +143,109 @@ func (s *secretsManagerClient) GetSecretValue(
 	return &body, nil
 }
 
+func (s *secretsManagerClient) ListSecrets(
+	ctx context.Context,
+	request *kms.ListSecretsRequest,
+) (*kms.ListSecretsResponseBody, error) {
+	resp, err := s.doAPICall(ctx, "ListSecrets", methodTypeGET, request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secrets: %w", err)
+	}
+
+	body, err := esutils.ConvertToType[kms.ListSecretsResponseBody](resp)
+	if err != nil {
+		return nil, fmt.Errorf("error converting body: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (s *secretsManagerClient) DescribeSecret(
+	ctx context.Context,
+	request *kms.DescribeSecretRequest,
+) (*kms.DescribeSecretResponseBody, error) {
+	resp, err := s.doAPICall(ctx, "DescribeSecret", methodTypeGET, request)
+	if err != nil {
+		return nil, fmt.Errorf("error describing secret [%s]: %w", esutils.Deref(request.SecretName), err)
+	}
+
+	body, err := esutils.ConvertToType[kms.DescribeSecretResponseBody](resp)
+	if err != nil {
+		return nil, fmt.Errorf("error converting body: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (s *secretsManagerClient) CreateSecret(
+	ctx context.Context,
+	request *kms.CreateSecretRequest,
+) (*kms.CreateSecretResponseBody, error) {
+	resp, err := s.doAPICall(ctx, "CreateSecret", methodTypePOST, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating secret [%s]: %w", esutils.Deref(request.SecretName), err)
+	}
+
+	body, err := esutils.ConvertToType[kms.CreateSecretResponseBody](resp)
+	if err != nil {
+		return nil, fmt.Errorf("error converting body: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (s *secretsManagerClient) PutSecretValue(
+	ctx context.Context,
+	request *kms.PutSecretValueRequest,
+) (*kms.PutSecretValueResponseBody, error) {
+	resp, err := s.doAPICall(ctx, "PutSecretValue", methodTypePOST, request)
+	if err != nil {
+		return nil, fmt.Errorf("error putting secret [%s] value: %w", esutils.Deref(request.SecretName), err)
+	}
+
+	body, err := esutils.ConvertToType[kms.PutSecretValueResponseBody](resp)
+	if err != nil {
+		return nil, fmt.Errorf("error converting body: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (s *secretsManagerClient) DeleteSecret(
+	ctx context.Context,
+	request *kms.DeleteSecretRequest,
+) (*kms.DeleteSecretResponseBody, error) {
+	resp, err := s.doAPICall(ctx, "DeleteSecret", methodTypePOST, request)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting secret [%s]: %w", esutils.Deref(request.SecretName), err)
+	}
+
+	body, err := esutils.ConvertToType[kms.DeleteSecretResponseBody](resp)
+	if err != nil {
+		return nil, fmt.Errorf("error converting body: %w", err)
+	}
+
+	return &body, nil
+}
+
 func (s *secretsManagerClient) doAPICall(ctx context.Context,
 	action string,
+	method methodType,
 	request any) (any, error) {
 	creds, err := s.config.Credential.GetCredential()
 	if err != nil {
 		return nil, fmt.Errorf("could not get credentials: %w", err)
 	}
 
-	apiRequest := newOpenAPIRequest(s.endpoint, action, methodTypeGET, request)
+	apiRequest := newOpenAPIRequest(s.endpoint, action, method, request)
 	apiRequest.query["AccessKeyId"] = creds.AccessKeyId
 
 	if esutils.Deref(creds.SecurityToken) != "" {
 		apiRequest.query["SecurityToken"] = creds.SecurityToken
 	}
 
-	apiRequest.query["Signature"] = openapiutil.GetRPCSignature(apiRequest.query, esutils.Ptr(apiRequest.method.String()), creds.AccessKeySecret)
+	signedParams := tea.Merge(apiRequest.query, apiRequest.body)
+	apiRequest.query["Signature"] = openapiutil.GetRPCSignature(signedParams, esutils.Ptr(apiRequest.method.String()), creds.AccessKeySecret)
 
 	httpReq, err := newHTTPRequestWithContext(ctx, apiRequest)
 	if err != nil {
@@ -199,7 +307,8 @@ func (s *secretsManagerClient) parseErrorResponse(resp *http.Response) error {
 type methodType string
 
 const (
-	methodTypeGET = "GET"
+	methodTypeGET  methodType = "GET"
+	methodTypePOST methodType = "POST"
 )
 
 func (m methodType) String() string {
@@ -211,6 +320,7 @@ type openAPIRequest struct {
 	method   methodType
 	headers  map[string]*string
 	query    map[string]*string
+	body     map[string]*string
 }
 
 func newOpenAPIRequest(endpoint string,
@@ -238,6 +348,13 @@ func newOpenAPIRequest(endpoint string,
 		},
 	}
 
+	// Write operations carry the secret value, which must not end up in the URL.
+	if method == methodTypePOST {
+		req.headers["content-type"] = esutils.Ptr("application/x-www-form-urlencoded")
+		req.body = openapiutil.Query(request)
+		return req
+	}
+
 	req.query = tea.Merge(req.query, openapiutil.Query(request))
 	return req
 }
@@ -249,7 +366,16 @@ func newHTTPRequestWithContext(ctx context.Context,
 		query.Add(k, esutils.Deref(v))
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, req.method.String(), fmt.Sprintf("https://%s/?%s", url.PathEscape(req.endpoint), query.Encode()), http.NoBody)
+	var body io.Reader = http.NoBody
+	if len(req.body) > 0 {
+		form := url.Values{}
+		for k, v := range req.body {
+			form.Add(k, esutils.Deref(v))
+		}
+		body = strings.NewReader(form.Encode())
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method.String(), fmt.Sprintf("https://%s/?%s", url.PathEscape(req.endpoint), query.Encode()), body)
 	if err != nil {
 		return nil, fmt.Errorf("error converting OpenAPI request to http request: %w", err)
 	}
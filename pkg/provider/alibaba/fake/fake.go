@@ -25,7 +25,12 @@ import (
 
 // AlibabaMockClient implements a mock client for Alibaba KMS service.
 type AlibabaMockClient struct {
-	getSecretValue func(request *kmssdk.GetSecretValueRequest) (response *kmssdk.GetSecretValueResponseBody, err error)
+	getSecretValue   func(request *kmssdk.GetSecretValueRequest) (response *kmssdk.GetSecretValueResponseBody, err error)
+	ListSecretsFn    func(request *kmssdk.ListSecretsRequest) (*kmssdk.ListSecretsResponseBody, error)
+	DescribeSecretFn func(request *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error)
+	CreateSecretFn   func(request *kmssdk.CreateSecretRequest) (*kmssdk.CreateSecretResponseBody, error)
+	PutSecretValueFn func(request *kmssdk.PutSecretValueRequest) (*kmssdk.PutSecretValueResponseBody, error)
+	DeleteSecretFn   func(request *kmssdk.DeleteSecretRequest) (*kmssdk.DeleteSecretResponseBody, error)
 }
 
 // GetSecretValue retrieves a secret value from the mock Alibaba client.
@@ -42,6 +47,31 @@ func (mc *AlibabaMockClient) WithValue(_ *kmssdk.GetSecretValueRequest, val *kms
 	}
 }
 
+// ListSecrets lists secrets using the configured ListSecretsFn.
+func (mc *AlibabaMockClient) ListSecrets(_ context.Context, request *kmssdk.ListSecretsRequest) (*kmssdk.ListSecretsResponseBody, error) {
+	return mc.ListSecretsFn(request)
+}
+
+// DescribeSecret describes a secret using the configured DescribeSecretFn.
+func (mc *AlibabaMockClient) DescribeSecret(_ context.Context, request *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error) {
+	return mc.DescribeSecretFn(request)
+}
+
+// CreateSecret creates a secret using the configured CreateSecretFn.
+func (mc *AlibabaMockClient) CreateSecret(_ context.Context, request *kmssdk.CreateSecretRequest) (*kmssdk.CreateSecretResponseBody, error) {
+	return mc.CreateSecretFn(request)
+}
+
+// PutSecretValue stores a new secret version using the configured PutSecretValueFn.
+func (mc *AlibabaMockClient) PutSecretValue(_ context.Context, request *kmssdk.PutSecretValueRequest) (*kmssdk.PutSecretValueResponseBody, error) {
+	return mc.PutSecretValueFn(request)
+}
+
+// DeleteSecret deletes a secret using the configured DeleteSecretFn.
+func (mc *AlibabaMockClient) DeleteSecret(_ context.Context, request *kmssdk.DeleteSecretRequest) (*kmssdk.DeleteSecretResponseBody, error) {
+	return mc.DeleteSecretFn(request)
+}
+
 // Endpoint returns the endpoint URL of the mock Alibaba client.
 func (mc *AlibabaMockClient) Endpoint() string {
 	return ""
@@ -21,20 +21,29 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	kmssdk "github.com/alibabacloud-go/kms-20160120/v3/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
 	credential "github.com/aliyun/credentials-go/credentials"
 	"github.com/avast/retry-go/v4"
+	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 	corev1 "k8s.io/api/core/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
+	"github.com/external-secrets/external-secrets/pkg/esutils/metadata"
 	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/find"
 )
 
 const (
@@ -42,9 +51,29 @@ const (
 	errUninitalizedAlibabaProvider = "provider Alibaba is not initialized"
 	errFetchAccessKeyID            = "could not fetch AccessKeyID secret: %w"
 	errFetchAccessKeySecret        = "could not fetch AccessKeySecret secret: %w"
-	errNotImplemented              = "not implemented"
+	errUnexpectedFindOperator      = "unexpected find operator"
+	errSecretNotManaged            = "secret not managed by external-secrets"
+
+	managedBy       = "managed-by"
+	externalSecrets = "external-secrets"
+
+	// errCodeResourceNotFound is returned by Secrets Manager when the requested secret does not exist.
+	errCodeResourceNotFound = "Forbidden.ResourceNotFound"
+	listSecretsPageSize     = 100
 )
 
+// PushSecretMetadataSpec contains metadata information for pushing secrets to Alibaba Secrets Manager.
+type PushSecretMetadataSpec struct {
+	// Tags are attached to the secret when it is created.
+	Tags map[string]string `json:"tags,omitempty"`
+	// Description is set on the secret when it is created.
+	Description string `json:"description,omitempty"`
+	// EncryptionKeyID is the ID of the KMS key used to encrypt the secret when it is created.
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
+	// VersionStages are the stage labels attached to the pushed version. Defaults to ACSCurrent.
+	VersionStages []string `json:"versionStages,omitempty"`
+}
+
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1.SecretsClient = &KeyManagementService{}
 var _ esv1.Provider = &KeyManagementService{}
@@ -53,33 +82,343 @@ var _ esv1.Provider = &KeyManagementService{}
 type KeyManagementService struct {
 	Client SMInterface
 	Config *openapi.Config
+	// SecretsManager holds the optional deletion settings of the store.
+	SecretsManager *esv1.AlibabaSecretsManager
+	newUUID        func() string
 }
 
 // SMInterface defines the interface for interacting with the Alibaba Secrets Manager.
 type SMInterface interface {
 	GetSecretValue(ctx context.Context, request *kmssdk.GetSecretValueRequest) (*kmssdk.GetSecretValueResponseBody, error)
+	ListSecrets(ctx context.Context, request *kmssdk.ListSecretsRequest) (*kmssdk.ListSecretsResponseBody, error)
+	DescribeSecret(ctx context.Context, request *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error)
+	CreateSecret(ctx context.Context, request *kmssdk.CreateSecretRequest) (*kmssdk.CreateSecretResponseBody, error)
+	PutSecretValue(ctx context.Context, request *kmssdk.PutSecretValueRequest) (*kmssdk.PutSecretValueResponseBody, error)
+	DeleteSecret(ctx context.Context, request *kmssdk.DeleteSecretRequest) (*kmssdk.DeleteSecretResponseBody, error)
 	Endpoint() string
 }
 
-// PushSecret implements the SecretsClient PushSecret interface for Alibaba Cloud KMS.
-func (kms *KeyManagementService) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1.PushSecretData) error {
-	return errors.New(errNotImplemented)
+// PushSecret creates the secret in Alibaba Secrets Manager or pushes a new version of its value.
+// Existing secrets are only updated if they carry the managed-by tag of external-secrets.
+func (kms *KeyManagementService) PushSecret(ctx context.Context, secret *corev1.Secret, psd esv1.PushSecretData) error {
+	if esutils.IsNil(kms.Client) {
+		return errors.New(errUninitalizedAlibabaProvider)
+	}
+
+	value, err := esutils.ExtractSecretData(psd, secret)
+	if err != nil {
+		return fmt.Errorf("failed to extract secret data: %w", err)
+	}
+
+	meta, err := metadata.ParseMetadataParameters[PushSecretMetadataSpec](psd.GetMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to parse push secret metadata: %w", err)
+	}
+	if meta == nil {
+		meta = &metadata.PushSecretMetadata[PushSecretMetadataSpec]{}
+	}
+
+	secretName := psd.GetRemoteKey()
+	describeOut, err := kms.Client.DescribeSecret(ctx, &kmssdk.DescribeSecretRequest{
+		SecretName: &secretName,
+		FetchTags:  esutils.Ptr("true"),
+	})
+	if err != nil {
+		if !isNotFoundErr(err) {
+			return SanitizeErr(err)
+		}
+		newValue, err := mergeSecretValue(value, psd.GetProperty(), "")
+		if err != nil {
+			return err
+		}
+		return kms.createSecret(ctx, secretName, newValue, &meta.Spec)
+	}
+
+	if !isManagedByESO(describeOut) {
+		return errors.New(errSecretNotManaged)
+	}
+
+	current, err := kms.Client.GetSecretValue(ctx, &kmssdk.GetSecretValueRequest{
+		SecretName: &secretName,
+	})
+	if err != nil {
+		return SanitizeErr(err)
+	}
+	currentValue := esutils.Deref(current.SecretData)
+
+	newValue, err := mergeSecretValue(value, psd.GetProperty(), currentValue)
+	if err != nil {
+		return err
+	}
+	if newValue == currentValue {
+		return nil
+	}
+
+	request := &kmssdk.PutSecretValueRequest{
+		SecretName: &secretName,
+		SecretData: &newValue,
+		VersionId:  esutils.Ptr(kms.versionID()),
+	}
+	if len(meta.Spec.VersionStages) > 0 {
+		stages, err := json.Marshal(meta.Spec.VersionStages)
+		if err != nil {
+			return fmt.Errorf("failed to marshal version stages: %w", err)
+		}
+		request.VersionStages = esutils.Ptr(string(stages))
+	}
+	if _, err := kms.Client.PutSecretValue(ctx, request); err != nil {
+		return SanitizeErr(err)
+	}
+
+	return nil
+}
+
+func (kms *KeyManagementService) createSecret(ctx context.Context, secretName, value string, spec *PushSecretMetadataSpec) error {
+	tags := []map[string]string{{"TagKey": managedBy, "TagValue": externalSecrets}}
+	for _, k := range slices.Sorted(maps.Keys(spec.Tags)) {
+		if k == managedBy {
+			continue
+		}
+		tags = append(tags, map[string]string{"TagKey": k, "TagValue": spec.Tags[k]})
+	}
+	rawTags, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tags: %w", err)
+	}
+
+	request := &kmssdk.CreateSecretRequest{
+		SecretName: &secretName,
+		SecretData: &value,
+		VersionId:  esutils.Ptr(kms.versionID()),
+		Tags:       esutils.Ptr(string(rawTags)),
+	}
+	if spec.Description != "" {
+		request.Description = &spec.Description
+	}
+	if spec.EncryptionKeyID != "" {
+		request.EncryptionKeyId = &spec.EncryptionKeyID
+	}
+	if _, err := kms.Client.CreateSecret(ctx, request); err != nil {
+		return SanitizeErr(err)
+	}
+
+	return nil
+}
+
+// mergeSecretValue returns the value to be stored remotely. When a property is given
+// the value is set as a field of the JSON document stored in the existing secret.
+func mergeSecretValue(value []byte, property, current string) (string, error) {
+	if property == "" {
+		return string(value), nil
+	}
+	if current != "" && !gjson.Valid(current) {
+		return "", errors.New("PushSecret for Alibaba Secrets Manager with a pushSecretData property requires a json secret")
+	}
+
+	merged, err := sjson.Set(current, property, string(value))
+	if err != nil {
+		return "", fmt.Errorf("failed to set property %s: %w", property, err)
+	}
+	return merged, nil
+}
+
+func (kms *KeyManagementService) versionID() string {
+	if kms.newUUID != nil {
+		return kms.newUUID()
+	}
+	return uuid.NewString()
+}
+
+// DeleteSecret deletes a secret that was created by external-secrets from Alibaba Secrets Manager.
+// The recovery window is configured through the store's secretsManager settings.
+func (kms *KeyManagementService) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	if esutils.IsNil(kms.Client) {
+		return errors.New(errUninitalizedAlibabaProvider)
+	}
+
+	secretName := remoteRef.GetRemoteKey()
+	describeOut, err := kms.Client.DescribeSecret(ctx, &kmssdk.DescribeSecretRequest{
+		SecretName: &secretName,
+		FetchTags:  esutils.Ptr("true"),
+	})
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil
+		}
+		return SanitizeErr(err)
+	}
+	if !isManagedByESO(describeOut) {
+		return nil
+	}
+
+	request := &kmssdk.DeleteSecretRequest{
+		SecretName: &secretName,
+	}
+	if kms.SecretsManager != nil {
+		if kms.SecretsManager.ForceDeleteWithoutRecovery && kms.SecretsManager.RecoveryWindowInDays > 0 {
+			return errors.New("forceDeleteWithoutRecovery and recoveryWindowInDays must not be set at the same time")
+		}
+		if kms.SecretsManager.ForceDeleteWithoutRecovery {
+			request.ForceDeleteWithoutRecovery = esutils.Ptr("true")
+		}
+		if kms.SecretsManager.RecoveryWindowInDays > 0 {
+			request.RecoveryWindowInDays = esutils.Ptr(strconv.FormatInt(kms.SecretsManager.RecoveryWindowInDays, 10))
+		}
+	}
+	if _, err := kms.Client.DeleteSecret(ctx, request); err != nil {
+		if isNotFoundErr(err) {
+			return nil
+		}
+		return SanitizeErr(err)
+	}
+
+	return nil
+}
+
+// SecretExists checks if a secret exists in Alibaba Secrets Manager.
+func (kms *KeyManagementService) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	if esutils.IsNil(kms.Client) {
+		return false, errors.New(errUninitalizedAlibabaProvider)
+	}
+
+	_, err := kms.Client.DescribeSecret(ctx, &kmssdk.DescribeSecretRequest{
+		SecretName: esutils.Ptr(remoteRef.GetRemoteKey()),
+	})
+	if err != nil {
+		if isNotFoundErr(err) {
+			return false, nil
+		}
+		return false, SanitizeErr(err)
+	}
+
+	return true, nil
 }
 
-// DeleteSecret implements the SecretsClient DeleteSecret interface for Alibaba Cloud KMS.
-func (kms *KeyManagementService) DeleteSecret(_ context.Context, _ esv1.PushSecretRemoteRef) error {
-	return errors.New(errNotImplemented)
+func isManagedByESO(data *kmssdk.DescribeSecretResponseBody) bool {
+	if data == nil || data.Tags == nil {
+		return false
+	}
+	for _, tag := range data.Tags.Tag {
+		if esutils.Deref(tag.TagKey) == managedBy && esutils.Deref(tag.TagValue) == externalSecrets {
+			return true
+		}
+	}
+	return false
 }
 
-// SecretExists implements the SecretsClient SecretExists interface for Alibaba Cloud KMS.
-func (kms *KeyManagementService) SecretExists(_ context.Context, _ esv1.PushSecretRemoteRef) (bool, error) {
-	return false, errors.New(errNotImplemented)
+func isNotFoundErr(err error) bool {
+	var sdkErr *tea.SDKError
+	if !errors.As(err, &sdkErr) {
+		return false
+	}
+	return esutils.Deref(sdkErr.Code) == errCodeResourceNotFound
 }
 
-// GetAllSecrets returns all secrets from the provider.
-func (kms *KeyManagementService) GetAllSecrets(_ context.Context, _ esv1.ExternalSecretFind) (map[string][]byte, error) {
-	// TO be implemented
-	return nil, errors.New(errNotImplemented)
+// GetAllSecrets lists the secrets of the region and returns the values of all secrets
+// matching the name regexp, the path prefix and the tags of the find operator.
+func (kms *KeyManagementService) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	if esutils.IsNil(kms.Client) {
+		return nil, errors.New(errUninitalizedAlibabaProvider)
+	}
+	if ref.Name == nil && len(ref.Tags) == 0 {
+		return nil, errors.New(errUnexpectedFindOperator)
+	}
+
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
+	}
+
+	request := &kmssdk.ListSecretsRequest{
+		FetchTags: esutils.Ptr("true"),
+		PageSize:  esutils.Ptr(int32(listSecretsPageSize)),
+	}
+	if len(ref.Tags) > 0 {
+		filters, err := tagFilters(ref.Tags)
+		if err != nil {
+			return nil, err
+		}
+		request.Filters = &filters
+	}
+
+	data := make(map[string][]byte)
+	for page := int32(1); ; page++ {
+		request.PageNumber = esutils.Ptr(page)
+		out, err := kms.Client.ListSecrets(ctx, request)
+		if err != nil {
+			return nil, SanitizeErr(err)
+		}
+		if out.SecretList == nil || len(out.SecretList.Secret) == 0 {
+			break
+		}
+
+		for _, secret := range out.SecretList.Secret {
+			name := esutils.Deref(secret.SecretName)
+			if ref.Path != nil && !strings.HasPrefix(name, *ref.Path) {
+				continue
+			}
+			if matcher != nil && !matcher.MatchName(name) {
+				continue
+			}
+			if !matchTags(ref.Tags, secret.Tags) {
+				continue
+			}
+			log.V(1).Info("alibaba kms findAll matches", "name", name)
+			value, err := kms.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{Key: name})
+			if err != nil {
+				return nil, err
+			}
+			data[name] = value
+		}
+
+		if page*listSecretsPageSize >= esutils.Deref(out.TotalCount) {
+			break
+		}
+	}
+
+	return data, nil
+}
+
+// tagFilters builds the ListSecrets filter expression. Filtering by tag on the server side
+// only narrows the result set, an exact match is done in matchTags.
+func tagFilters(tags map[string]string) (string, error) {
+	keys := slices.Sorted(maps.Keys(tags))
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, tags[k])
+	}
+	filters := []map[string]any{
+		{"Key": "TagKey", "Values": keys},
+		{"Key": "TagValue", "Values": values},
+	}
+	raw, err := json.Marshal(filters)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal filters: %w", err)
+	}
+	return string(raw), nil
+}
+
+func matchTags(want map[string]string, tags *kmssdk.ListSecretsResponseBodySecretListSecretTags) bool {
+	if len(want) == 0 {
+		return true
+	}
+	if tags == nil {
+		return false
+	}
+	got := make(map[string]string, len(tags.Tag))
+	for _, tag := range tags.Tag {
+		got[esutils.Deref(tag.TagKey)] = esutils.Deref(tag.TagValue)
+	}
+	for k, v := range want {
+		if val, ok := got[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
 }
 
 // GetSecret returns a single secret from the provider.
@@ -137,7 +476,7 @@ func (kms *KeyManagementService) GetSecretMap(ctx context.Context, ref esv1.Exte
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
 func (kms *KeyManagementService) Capabilities() esv1.SecretStoreCapabilities {
-	return esv1.SecretStoreReadOnly
+	return esv1.SecretStoreReadWrite
 }
 
 // NewClient constructs a new secrets client based on the provided store.
@@ -161,9 +500,11 @@ func (kms *KeyManagementService) NewClient(ctx context.Context, store esv1.Gener
 		return nil, fmt.Errorf(errAlibabaClient, err)
 	}
 
-	kms.Client = client
-	kms.Config = config
-	return kms, nil
+	return &KeyManagementService{
+		Client:         client,
+		Config:         config,
+		SecretsManager: alibabaSpec.SecretsManager,
+	}, nil
 }
 
 func newOptions(store esv1.GenericStore) *util.RuntimeOptions {
@@ -24,8 +24,12 @@ import (
 	"testing"
 
 	kmssdk "github.com/alibabacloud-go/kms-20160120/v3/client"
+	"github.com/alibabacloud-go/tea/tea"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
 	fakesm "github.com/external-secrets/external-secrets/pkg/provider/alibaba/fake"
@@ -245,3 +249,316 @@ func ErrorContains(out error, want string) bool {
 	}
 	return strings.Contains(out.Error(), want)
 }
+
+var errNotFound = tea.NewSDKError(map[string]any{
+	"code":    errCodeResourceNotFound,
+	"message": "secret not found",
+})
+
+func managedDescribeOutput() *kmssdk.DescribeSecretResponseBody {
+	return &kmssdk.DescribeSecretResponseBody{
+		SecretName: esutils.Ptr(secretName),
+		Tags: &kmssdk.DescribeSecretResponseBodyTags{
+			Tag: []*kmssdk.DescribeSecretResponseBodyTagsTag{
+				{TagKey: esutils.Ptr(managedBy), TagValue: esutils.Ptr(externalSecrets)},
+			},
+		},
+	}
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	listOutput := &kmssdk.ListSecretsResponseBody{
+		TotalCount: esutils.Ptr(int32(3)),
+		SecretList: &kmssdk.ListSecretsResponseBodySecretList{
+			Secret: []*kmssdk.ListSecretsResponseBodySecretListSecret{
+				{
+					SecretName: esutils.Ptr("app/db-password"),
+					Tags: &kmssdk.ListSecretsResponseBodySecretListSecretTags{
+						Tag: []*kmssdk.ListSecretsResponseBodySecretListSecretTagsTag{
+							{TagKey: esutils.Ptr("team"), TagValue: esutils.Ptr("a")},
+						},
+					},
+				},
+				{
+					SecretName: esutils.Ptr("app/api-key"),
+					Tags: &kmssdk.ListSecretsResponseBodySecretListSecretTags{
+						Tag: []*kmssdk.ListSecretsResponseBodySecretListSecretTagsTag{
+							{TagKey: esutils.Ptr("team"), TagValue: esutils.Ptr("b")},
+						},
+					},
+				},
+				{
+					SecretName: esutils.Ptr("other/db-password"),
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ref          esv1.ExternalSecretFind
+		listErr      error
+		expectedKeys []string
+		expectError  string
+	}{
+		"find by name": {
+			ref:          esv1.ExternalSecretFind{Name: &esv1.FindName{RegExp: "db-password$"}},
+			expectedKeys: []string{"app/db-password", "other/db-password"},
+		},
+		"find by name and path": {
+			ref:          esv1.ExternalSecretFind{Name: &esv1.FindName{RegExp: "db-password$"}, Path: esutils.Ptr("app/")},
+			expectedKeys: []string{"app/db-password"},
+		},
+		"find by tags": {
+			ref:          esv1.ExternalSecretFind{Tags: map[string]string{"team": "b"}},
+			expectedKeys: []string{"app/api-key"},
+		},
+		"invalid regexp": {
+			ref:         esv1.ExternalSecretFind{Name: &esv1.FindName{RegExp: "("}},
+			expectError: "could not compile find.name.regexp",
+		},
+		"no find operator": {
+			ref:         esv1.ExternalSecretFind{},
+			expectError: errUnexpectedFindOperator,
+		},
+		"list error": {
+			ref:         esv1.ExternalSecretFind{Name: &esv1.FindName{RegExp: ".*"}},
+			listErr:     errors.New("oh no"),
+			expectError: "oh no",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockClient := &fakesm.AlibabaMockClient{}
+			mockClient.WithValue(nil, makeValidAPIOutput(), nil)
+			mockClient.ListSecretsFn = func(request *kmssdk.ListSecretsRequest) (*kmssdk.ListSecretsResponseBody, error) {
+				if tc.listErr != nil {
+					return nil, tc.listErr
+				}
+				if esutils.Deref(request.PageNumber) > 1 {
+					return &kmssdk.ListSecretsResponseBody{}, nil
+				}
+				return listOutput, nil
+			}
+			sm := KeyManagementService{Client: mockClient}
+			out, err := sm.GetAllSecrets(context.Background(), tc.ref)
+			if !ErrorContains(err, tc.expectError) {
+				t.Fatalf("unexpected error: %v, expected: '%s'", err, tc.expectError)
+			}
+			if tc.expectError != "" {
+				return
+			}
+			if len(out) != len(tc.expectedKeys) {
+				t.Fatalf("unexpected number of secrets: expected %v, got %v", tc.expectedKeys, out)
+			}
+			for _, k := range tc.expectedKeys {
+				if string(out[k]) != secretValue {
+					t.Errorf("unexpected value for %s: %s", k, string(out[k]))
+				}
+			}
+		})
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{"foo": []byte("bar")},
+	}
+
+	testCases := map[string]struct {
+		property       string
+		metadata       string
+		describeErr    error
+		describeOutput *kmssdk.DescribeSecretResponseBody
+		currentValue   string
+		expectCreate   *kmssdk.CreateSecretRequest
+		expectPut      *kmssdk.PutSecretValueRequest
+		expectError    string
+	}{
+		"creates missing secret": {
+			describeErr: errNotFound,
+			metadata:    `{"apiVersion":"kubernetes.external-secrets.io/v1alpha1","kind":"PushSecretMetadata","spec":{"description":"desc","tags":{"env":"prod"}}}`,
+			expectCreate: &kmssdk.CreateSecretRequest{
+				SecretName:  esutils.Ptr(secretName),
+				SecretData:  esutils.Ptr("bar"),
+				VersionId:   esutils.Ptr("version-1"),
+				Description: esutils.Ptr("desc"),
+				Tags:        esutils.Ptr(`[{"TagKey":"managed-by","TagValue":"external-secrets"},{"TagKey":"env","TagValue":"prod"}]`),
+			},
+		},
+		"puts new value with version stages": {
+			describeOutput: managedDescribeOutput(),
+			currentValue:   "old",
+			metadata:       `{"apiVersion":"kubernetes.external-secrets.io/v1alpha1","kind":"PushSecretMetadata","spec":{"versionStages":["ACSCurrent","ACSNext"]}}`,
+			expectPut: &kmssdk.PutSecretValueRequest{
+				SecretName:    esutils.Ptr(secretName),
+				SecretData:    esutils.Ptr("bar"),
+				VersionId:     esutils.Ptr("version-1"),
+				VersionStages: esutils.Ptr(`["ACSCurrent","ACSNext"]`),
+			},
+		},
+		"merges property into existing json": {
+			describeOutput: managedDescribeOutput(),
+			currentValue:   `{"other":"value"}`,
+			property:       "foo",
+			expectPut: &kmssdk.PutSecretValueRequest{
+				SecretName: esutils.Ptr(secretName),
+				SecretData: esutils.Ptr(`{"other":"value","foo":"bar"}`),
+				VersionId:  esutils.Ptr("version-1"),
+			},
+		},
+		"skips unchanged value": {
+			describeOutput: managedDescribeOutput(),
+			currentValue:   "bar",
+		},
+		"refuses unmanaged secret": {
+			describeOutput: &kmssdk.DescribeSecretResponseBody{SecretName: esutils.Ptr(secretName)},
+			expectError:    errSecretNotManaged,
+		},
+		"describe error": {
+			describeErr: errors.New("oh no"),
+			expectError: "oh no",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var created *kmssdk.CreateSecretRequest
+			var put *kmssdk.PutSecretValueRequest
+			mockClient := &fakesm.AlibabaMockClient{
+				DescribeSecretFn: func(_ *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error) {
+					return tc.describeOutput, tc.describeErr
+				},
+				CreateSecretFn: func(request *kmssdk.CreateSecretRequest) (*kmssdk.CreateSecretResponseBody, error) {
+					created = request
+					return &kmssdk.CreateSecretResponseBody{}, nil
+				},
+				PutSecretValueFn: func(request *kmssdk.PutSecretValueRequest) (*kmssdk.PutSecretValueResponseBody, error) {
+					put = request
+					return &kmssdk.PutSecretValueResponseBody{}, nil
+				},
+			}
+			mockClient.WithValue(nil, &kmssdk.GetSecretValueResponseBody{SecretData: esutils.Ptr(tc.currentValue)}, nil)
+
+			psd := v1alpha1.PushSecretData{
+				Match: v1alpha1.PushSecretMatch{
+					SecretKey: "foo",
+					RemoteRef: v1alpha1.PushSecretRemoteRef{
+						RemoteKey: secretName,
+						Property:  tc.property,
+					},
+				},
+			}
+			if tc.metadata != "" {
+				psd.Metadata = &apiextensionsv1.JSON{Raw: []byte(tc.metadata)}
+			}
+
+			sm := KeyManagementService{Client: mockClient, newUUID: func() string { return "version-1" }}
+			err := sm.PushSecret(context.Background(), secret, psd)
+			if !ErrorContains(err, tc.expectError) {
+				t.Fatalf("unexpected error: %v, expected: '%s'", err, tc.expectError)
+			}
+			if !reflect.DeepEqual(created, tc.expectCreate) {
+				t.Errorf("unexpected create request: expected %v, got %v", tc.expectCreate, created)
+			}
+			if !reflect.DeepEqual(put, tc.expectPut) {
+				t.Errorf("unexpected put request: expected %v, got %v", tc.expectPut, put)
+			}
+		})
+	}
+}
+
+func TestDeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		secretsManager *esv1.AlibabaSecretsManager
+		describeErr    error
+		describeOutput *kmssdk.DescribeSecretResponseBody
+		expectDelete   *kmssdk.DeleteSecretRequest
+		expectError    string
+	}{
+		"deletes managed secret with recovery window": {
+			secretsManager: &esv1.AlibabaSecretsManager{RecoveryWindowInDays: 7},
+			describeOutput: managedDescribeOutput(),
+			expectDelete: &kmssdk.DeleteSecretRequest{
+				SecretName:           esutils.Ptr(secretName),
+				RecoveryWindowInDays: esutils.Ptr("7"),
+			},
+		},
+		"force deletes managed secret": {
+			secretsManager: &esv1.AlibabaSecretsManager{ForceDeleteWithoutRecovery: true},
+			describeOutput: managedDescribeOutput(),
+			expectDelete: &kmssdk.DeleteSecretRequest{
+				SecretName:                 esutils.Ptr(secretName),
+				ForceDeleteWithoutRecovery: esutils.Ptr("true"),
+			},
+		},
+		"conflicting deletion settings": {
+			secretsManager: &esv1.AlibabaSecretsManager{ForceDeleteWithoutRecovery: true, RecoveryWindowInDays: 7},
+			describeOutput: managedDescribeOutput(),
+			expectError:    "must not be set at the same time",
+		},
+		"ignores unmanaged secret": {
+			describeOutput: &kmssdk.DescribeSecretResponseBody{SecretName: esutils.Ptr(secretName)},
+		},
+		"ignores missing secret": {
+			describeErr: errNotFound,
+		},
+		"describe error": {
+			describeErr: errors.New("oh no"),
+			expectError: "oh no",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var deleted *kmssdk.DeleteSecretRequest
+			mockClient := &fakesm.AlibabaMockClient{
+				DescribeSecretFn: func(_ *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error) {
+					return tc.describeOutput, tc.describeErr
+				},
+				DeleteSecretFn: func(request *kmssdk.DeleteSecretRequest) (*kmssdk.DeleteSecretResponseBody, error) {
+					deleted = request
+					return &kmssdk.DeleteSecretResponseBody{}, nil
+				},
+			}
+			sm := KeyManagementService{Client: mockClient, SecretsManager: tc.secretsManager}
+			err := sm.DeleteSecret(context.Background(), v1alpha1.PushSecretRemoteRef{RemoteKey: secretName})
+			if !ErrorContains(err, tc.expectError) {
+				t.Fatalf("unexpected error: %v, expected: '%s'", err, tc.expectError)
+			}
+			if !reflect.DeepEqual(deleted, tc.expectDelete) {
+				t.Errorf("unexpected delete request: expected %v, got %v", tc.expectDelete, deleted)
+			}
+		})
+	}
+}
+
+func TestSecretExists(t *testing.T) {
+	testCases := map[string]struct {
+		describeErr error
+		expected    bool
+		expectError string
+	}{
+		"exists":    {expected: true},
+		"not found": {describeErr: errNotFound},
+		"error":     {describeErr: errors.New("oh no"), expectError: "oh no"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockClient := &fakesm.AlibabaMockClient{
+				DescribeSecretFn: func(_ *kmssdk.DescribeSecretRequest) (*kmssdk.DescribeSecretResponseBody, error) {
+					return managedDescribeOutput(), tc.describeErr
+				},
+			}
+			sm := KeyManagementService{Client: mockClient}
+			exists, err := sm.SecretExists(context.Background(), v1alpha1.PushSecretRemoteRef{RemoteKey: secretName})
+			if !ErrorContains(err, tc.expectError) {
+				t.Fatalf("unexpected error: %v, expected: '%s'", err, tc.expectError)
+			}
+			if exists != tc.expected {
+				t.Errorf("unexpected result: expected %t, got %t", tc.expected, exists)
+			}
+		})
+	}
+}
@@ -443,6 +443,18 @@ func (sm *SecretsManager) GetSecret(ctx context.Context, ref esv1.ExternalSecret
 	return []byte(val.String()), nil
 }
 
+// GetSecretVersion returns the version id of a secret, it is served from the cache of GetSecret and GetSecretMap.
+func (sm *SecretsManager) GetSecretVersion(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (string, error) {
+	secretOut, err := sm.fetch(ctx, ref)
+	if errors.Is(err, esv1.NoSecretErr) {
+		return "", err
+	}
+	if err != nil {
+		return "", awsutil.SanitizeErr(err)
+	}
+	return utilpointer.Deref(secretOut.VersionId, ""), nil
+}
+
 func (sm *SecretsManager) mapSecretToGjson(secretOut *awssm.GetSecretValueOutput, property string) gjson.Result {
 	payload := sm.retrievePayload(secretOut)
 	refProperty := sm.escapeDotsIfRequired(property, payload)
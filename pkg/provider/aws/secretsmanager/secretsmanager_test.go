@@ -341,6 +341,31 @@ func TestCaching(t *testing.T) {
 	}
 }
 
+func TestGetSecretVersion(t *testing.T) {
+	smtc := makeValidSecretsManagerTestCaseCustom(func(smtc *secretsManagerTestCase) {
+		smtc.apiOutput.SecretString = aws.String("bar")
+		smtc.apiOutput.VersionId = aws.String("a1b2c3")
+	})
+	sm := SecretsManager{
+		client: smtc.fakeClient,
+		cache:  make(map[string]*awssm.GetSecretValueOutput),
+	}
+	if _, err := sm.GetSecret(context.Background(), *smtc.remoteRef); err != nil {
+		t.Fatal(err)
+	}
+	version, err := sm.GetSecretVersion(context.Background(), *smtc.remoteRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "a1b2c3" {
+		t.Errorf("unexpected version: expected a1b2c3, got %s", version)
+	}
+	// the version is served from the cache of GetSecret
+	if smtc.fakeClient.ExecutionCounter != 1 {
+		t.Errorf("unexpected counter value: expected 1, got %d", smtc.fakeClient.ExecutionCounter)
+	}
+}
+
 func TestGetSecretMap(t *testing.T) {
 	// good case: default version & deserialization
 	setDeserialization := func(smtc *secretsManagerTestCase) {
@@ -244,20 +244,7 @@ func initializeNewAzureSDK(ctx context.Context, az *Azure) error {
 		return fmt.Errorf("failed to get cloud configuration: %w", err)
 	}
 
-	// Build credential based on auth type
-	var credential azcore.TokenCredential
-
-	switch *az.provider.AuthType {
-	case esv1.AzureManagedIdentity:
-		credential, err = buildManagedIdentityCredential(az, cloudConfig)
-	case esv1.AzureServicePrincipal:
-		credential, err = buildServicePrincipalCredential(ctx, az, cloudConfig)
-	case esv1.AzureWorkloadIdentity:
-		credential, err = buildWorkloadIdentityCredential(ctx, az, cloudConfig)
-	default:
-		return errors.New(errMissingAuthType)
-	}
-
+	credential, err := buildCredential(ctx, az, cloudConfig)
 	if err != nil {
 		return err
 	}
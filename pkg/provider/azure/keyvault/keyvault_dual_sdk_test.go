@@ -522,3 +522,57 @@ func TestGetCloudConfiguration(t *testing.T) {
 		})
 	}
 }
+
+// TestNewGeneratorCredential tests that generators can build credentials from the provider auth options.
+func TestNewGeneratorCredential(t *testing.T) {
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "azure-creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"client-id":     []byte("client-id"),
+			"client-secret": []byte("client-secret"),
+		},
+	}).Build()
+	spAuth := ptr.To(esv1.AzureServicePrincipal)
+
+	t.Run("service_principal", func(t *testing.T) {
+		cred, cloudConfig, err := NewGeneratorCredential(context.Background(), kube, nil, &esv1.AzureKVProvider{
+			AuthType:        spAuth,
+			TenantID:        ptr.To("tenant-id"),
+			EnvironmentType: esv1.AzureEnvironmentChinaCloud,
+			AuthSecretRef: &esv1.AzureKVAuth{
+				ClientID:     &v1.SecretKeySelector{Name: "azure-creds", Key: "client-id"},
+				ClientSecret: &v1.SecretKeySelector{Name: "azure-creds", Key: "client-secret"},
+			},
+		}, "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cred == nil {
+			t.Fatal("expected credential")
+		}
+		if cloudConfig.ActiveDirectoryAuthorityHost != "https://login.chinacloudapi.cn/" {
+			t.Errorf("unexpected authority host %q", cloudConfig.ActiveDirectoryAuthorityHost)
+		}
+	})
+
+	t.Run("secrets_are_namespaced", func(t *testing.T) {
+		_, _, err := NewGeneratorCredential(context.Background(), kube, nil, &esv1.AzureKVProvider{
+			AuthType: spAuth,
+			TenantID: ptr.To("tenant-id"),
+			AuthSecretRef: &esv1.AzureKVAuth{
+				ClientID:     &v1.SecretKeySelector{Name: "azure-creds", Key: "client-id", Namespace: ptr.To("default")},
+				ClientSecret: &v1.SecretKeySelector{Name: "azure-creds", Key: "client-secret", Namespace: ptr.To("default")},
+			},
+		}, "other")
+		if err == nil {
+			t.Fatal("expected error when referencing secrets of another namespace")
+		}
+	})
+
+	t.Run("missing_auth_type", func(t *testing.T) {
+		_, _, err := NewGeneratorCredential(context.Background(), kube, nil, &esv1.AzureKVProvider{}, "default")
+		if err == nil {
+			t.Fatal("expected error without auth type")
+		}
+	})
+}
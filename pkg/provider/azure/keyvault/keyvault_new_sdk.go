@@ -36,6 +36,8 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	kcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	"github.com/external-secrets/external-secrets/pkg/constants"
@@ -377,6 +379,45 @@ func buildCustomCloudConfiguration(config *esv1.AzureCustomCloudConfig) (cloud.C
 	return cloudConfig, nil
 }
 
+// NewGeneratorCredential creates a credential for the auth options of the given provider spec,
+// so generators can authenticate the same way as the provider.
+// Secrets and service accounts are resolved in the given namespace.
+func NewGeneratorCredential(ctx context.Context, kube client.Client, corev1 kcorev1.CoreV1Interface, provider *esv1.AzureKVProvider, namespace string) (azcore.TokenCredential, cloud.Configuration, error) {
+	az := &Azure{
+		crClient:   kube,
+		kubeClient: corev1,
+		store:      &esv1.SecretStore{},
+		provider:   provider,
+		namespace:  namespace,
+	}
+	cloudConfig, err := getCloudConfiguration(provider)
+	if err != nil {
+		return nil, cloud.Configuration{}, fmt.Errorf("failed to get cloud configuration: %w", err)
+	}
+	credential, err := buildCredential(ctx, az, cloudConfig)
+	if err != nil {
+		return nil, cloud.Configuration{}, err
+	}
+	return credential, cloudConfig, nil
+}
+
+// buildCredential creates a credential based on the auth type of the provider.
+func buildCredential(ctx context.Context, az *Azure, cloudConfig cloud.Configuration) (azcore.TokenCredential, error) {
+	if az.provider.AuthType == nil {
+		return nil, errors.New(errMissingAuthType)
+	}
+	switch *az.provider.AuthType {
+	case esv1.AzureManagedIdentity:
+		return buildManagedIdentityCredential(az, cloudConfig)
+	case esv1.AzureServicePrincipal:
+		return buildServicePrincipalCredential(ctx, az, cloudConfig)
+	case esv1.AzureWorkloadIdentity:
+		return buildWorkloadIdentityCredential(ctx, az, cloudConfig)
+	default:
+		return nil, errors.New(errMissingAuthType)
+	}
+}
+
 // buildManagedIdentityCredential creates a ManagedIdentityCredential.
 func buildManagedIdentityCredential(az *Azure, cloudConfig cloud.Configuration) (azcore.TokenCredential, error) {
 	opts := &azidentity.ManagedIdentityCredentialOptions{
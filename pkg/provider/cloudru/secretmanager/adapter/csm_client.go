@@ -35,6 +35,8 @@ import (
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 )
 
+const versionsPageSize int32 = 100
+
 // CredentialsResolver returns the actual client credentials.
 type CredentialsResolver interface {
 	Resolve(ctx context.Context) (*Credentials, error)
@@ -166,6 +168,74 @@ func (c *APIClient) AccessSecretVersion(ctx context.Context, id, version string)
 	return secret.GetData().GetValue(), nil
 }
 
+// GetSecretByPath retrieves the secret metadata, including its versions, by the secret path.
+func (c *APIClient) GetSecretByPath(ctx context.Context, projectID, path string) (*smsV2.Secret, error) {
+	var err error
+	ctx, err = c.authCtx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	resp, err := c.smsClient.V2.SecretService.Get(ctx, &smsV2.GetSecretRequest{ProjectId: projectID, Path: path})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.NotFound {
+			return nil, esv1.NoSecretErr
+		}
+
+		return nil, fmt.Errorf("failed to get the secret by path '%s': %w", path, err)
+	}
+
+	return resp.GetSecret(), nil
+}
+
+// GetSecret retrieves the secret metadata, including its versions, by the secret identifier.
+func (c *APIClient) GetSecret(ctx context.Context, id string) (*smsV2.Secret, error) {
+	var err error
+	ctx, err = c.authCtx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	secret, err := c.smsClient.SecretService.GetSecret(ctx, &smsV1.GetSecretRequest{SecretId: id})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.NotFound {
+			return nil, esv1.NoSecretErr
+		}
+
+		return nil, fmt.Errorf("failed to get the secret by id '%s': %w", id, err)
+	}
+
+	out := &smsV2.Secret{
+		Id:          secret.GetId(),
+		Name:        secret.GetName(),
+		Description: secret.GetDescription(),
+		Labels:      secret.GetLabels(),
+		CreatedAt:   secret.GetCreatedAt(),
+	}
+
+	page := &smsV1.Page{Limit: versionsPageSize}
+	for {
+		resp, err := c.smsClient.SecretService.ListSecretVersions(ctx, &smsV1.ListSecretVersionsRequest{SecretId: id, Page: page})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the versions of the secret '%s': %w", id, err)
+		}
+
+		for _, v := range resp.GetVersions() {
+			out.Versions = append(out.Versions, &smsV2.SecretVersion{
+				Id:        v.GetId(),
+				State:     smsV2.VersionState(v.GetState()),
+				CreatedAt: v.GetCreatedAt(),
+			})
+		}
+		if len(resp.GetVersions()) < int(versionsPageSize) {
+			return out, nil
+		}
+		page.Offset += versionsPageSize
+	}
+}
+
 func (c *APIClient) authCtx(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromOutgoingContext(ctx)
 	if !ok {
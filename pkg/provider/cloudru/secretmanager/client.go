@@ -37,7 +37,14 @@ import (
 
 var (
 	// ErrInvalidSecretVersion represents the error, when trying to access the secret with non-numeric version.
-	ErrInvalidSecretVersion = errors.New("invalid secret version: should be a valid int32 value or 'latest' keyword")
+	ErrInvalidSecretVersion = errors.New("invalid secret version: should be a valid int32 value, 'latest' or 'latest_enabled' keyword")
+	// ErrNoEnabledSecretVersion represents the error, when the secret has no enabled version.
+	ErrNoEnabledSecretVersion = errors.New("the secret has no enabled version")
+)
+
+const (
+	versionLatest        = "latest"
+	versionLatestEnabled = "latest_enabled"
 )
 
 // SecretProvider is an API client for the Cloud.ru Secret Manager.
@@ -48,6 +55,25 @@ type SecretProvider interface {
 	AccessSecretVersionByPath(ctx context.Context, projectID, path string, version *int32) ([]byte, error)
 	// AccessSecretVersion gets the secret by the given request.
 	AccessSecretVersion(ctx context.Context, id, version string) ([]byte, error)
+	// GetSecretByPath gets the secret metadata by the given path.
+	GetSecretByPath(ctx context.Context, projectID, path string) (*smsv2.Secret, error)
+	// GetSecret gets the secret metadata by the given identifier.
+	GetSecret(ctx context.Context, id string) (*smsv2.Secret, error)
+}
+
+// secretMetadata is the representation of the secret returned when the metadata is fetched.
+type secretMetadata struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Path        string            `json:"path,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels"`
+	Versions    []versionMetadata `json:"versions"`
+}
+
+type versionMetadata struct {
+	ID    int32  `json:"id"`
+	State string `json:"state"`
 }
 
 // Client is a provider for CloudRu Secret Manager.
@@ -59,7 +85,7 @@ type Client struct {
 
 // GetSecret gets the secret by the remote reference.
 func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
-	secret, err := c.accessSecret(ctx, ref.Key, ref.Version)
+	secret, err := c.getSecretValue(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +110,16 @@ func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemot
 		return nil, fmt.Errorf("the requested property %q does not exist in secret %q", prop, ref.Key)
 	}
 
-	return []byte(result.Str), nil
+	if result.Type == gjson.String {
+		return []byte(result.Str), nil
+	}
+
+	return []byte(result.Raw), nil
 }
 
 // GetSecretMap retrieves a secret from CloudRu SecretManager and returns it as a map of key/value pairs.
 func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
-	secret, err := c.accessSecret(ctx, ref.Key, ref.Version)
+	secret, err := c.getSecretValue(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -141,28 +171,106 @@ func (c *Client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind)
 	return esutils.ConvertKeys(ref.ConversionStrategy, out)
 }
 
+// getSecretValue returns either the secret payload or, when the metadata policy is Fetch, the secret metadata as JSON.
+func (c *Client) getSecretValue(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.MetadataPolicy == esv1.ExternalSecretMetadataPolicyFetch {
+		return c.getSecretMetadata(ctx, ref.Key)
+	}
+
+	return c.accessSecret(ctx, ref.Key, ref.Version)
+}
+
 func (c *Client) accessSecret(ctx context.Context, key, version string) ([]byte, error) {
+	if version == versionLatestEnabled {
+		secret, err := c.getSecret(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		enabled, err := latestEnabledVersion(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to access secret %q: %w", key, err)
+		}
+		version = strconv.Itoa(int(enabled))
+	}
+
+	versionNum, err := parseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
 	// check if the secret key is UUID
 	// The uuid value means that the provided `key` is a secret identifier.
 	// if not, then it is a secret name, and we need to get the secret by
 	// name before accessing the version.
 	if _, err := uuid.Parse(key); err != nil {
-		var versionNum *int32
-		if version != "" && version != "latest" {
-			num, parseErr := strconv.ParseInt(version, 10, 32)
-			if parseErr != nil {
-				return nil, ErrInvalidSecretVersion
-			}
-
-			versionNum = &[]int32{int32(num)}[0]
-		}
-
 		return c.apiClient.AccessSecretVersionByPath(ctx, c.projectID, key, versionNum)
 	}
 
 	return c.apiClient.AccessSecretVersion(ctx, key, version)
 }
 
+func (c *Client) getSecret(ctx context.Context, key string) (*smsv2.Secret, error) {
+	if _, err := uuid.Parse(key); err != nil {
+		return c.apiClient.GetSecretByPath(ctx, c.projectID, key)
+	}
+
+	return c.apiClient.GetSecret(ctx, key)
+}
+
+func (c *Client) getSecretMetadata(ctx context.Context, key string) ([]byte, error) {
+	secret, err := c.getSecret(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := secretMetadata{
+		ID:          secret.GetId(),
+		Name:        secret.GetName(),
+		Path:        secret.GetPath(),
+		Description: secret.GetDescription(),
+		Labels:      secret.GetLabels(),
+		Versions:    make([]versionMetadata, 0, len(secret.GetVersions())),
+	}
+	for _, v := range secret.GetVersions() {
+		meta.Versions = append(meta.Versions, versionMetadata{ID: v.GetId(), State: v.GetState().String()})
+	}
+
+	return json.Marshal(meta)
+}
+
+// parseVersion returns the numeric version, or nil for the latest version.
+func parseVersion(version string) (*int32, error) {
+	if version == "" || version == versionLatest {
+		return nil, nil
+	}
+
+	num, err := strconv.ParseInt(version, 10, 32)
+	if err != nil {
+		return nil, ErrInvalidSecretVersion
+	}
+
+	return &[]int32{int32(num)}[0], nil
+}
+
+func latestEnabledVersion(secret *smsv2.Secret) (int32, error) {
+	var (
+		latest int32
+		found  bool
+	)
+	for _, v := range secret.GetVersions() {
+		if v.GetState() == smsv2.VersionState_ENABLED && (!found || v.GetId() > latest) {
+			latest = v.GetId()
+			found = true
+		}
+	}
+	if !found {
+		return 0, ErrNoEnabledSecretVersion
+	}
+
+	return latest, nil
+}
+
 // PushSecret pushes a secret to CloudRu Secret Manager.
 func (c *Client) PushSecret(context.Context, *corev1.Secret, esv1.PushSecretData) error {
 	return fmt.Errorf("push secret is not supported")
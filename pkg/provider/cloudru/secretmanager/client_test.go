@@ -153,6 +153,81 @@ func TestClientGetSecret(t *testing.T) {
 			wantPayload: nil,
 			wantErr:     fmt.Errorf(`the requested property "unexpected" does not exist in secret %q`, keyID),
 		},
+		{
+			name: "success_latest_enabled",
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:     "very_secret",
+				Version: "latest_enabled",
+			},
+			setup: func(mock *fake.MockSecretProvider) {
+				mock.MockGetSecret(&smsV2.Secret{
+					Id: keyID,
+					Versions: []*smsV2.SecretVersion{
+						{Id: 1, State: smsV2.VersionState_ENABLED},
+						{Id: 2, State: smsV2.VersionState_ENABLED},
+						{Id: 3, State: smsV2.VersionState_DISABLED},
+					},
+				}, nil)
+				mock.MockAccessSecretVersionPath([]byte("secret v2"), nil)
+			},
+			wantPayload: []byte("secret v2"),
+			wantErr:     nil,
+		},
+		{
+			name: "error_latest_enabled:no_enabled_version",
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:     keyID,
+				Version: "latest_enabled",
+			},
+			setup: func(mock *fake.MockSecretProvider) {
+				mock.MockGetSecret(&smsV2.Secret{
+					Id:       keyID,
+					Versions: []*smsV2.SecretVersion{{Id: 1, State: smsV2.VersionState_DESTROYED}},
+				}, nil)
+			},
+			wantPayload: nil,
+			wantErr:     fmt.Errorf("failed to access secret %q: %w", keyID, ErrNoEnabledSecretVersion),
+		},
+		{
+			name: "error_access_secret:invalid_version",
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:     keyID,
+				Version: "latest_disabled",
+			},
+			setup:       func(mock *fake.MockSecretProvider) {},
+			wantPayload: nil,
+			wantErr:     ErrInvalidSecretVersion,
+		},
+		{
+			name: "success_fetch_metadata",
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:            "very_secret",
+				MetadataPolicy: esv1.ExternalSecretMetadataPolicyFetch,
+			},
+			setup: func(mock *fake.MockSecretProvider) {
+				mock.MockGetSecret(&smsV2.Secret{
+					Id:       keyID,
+					Name:     "very_secret",
+					Labels:   map[string]string{"env": "prod"},
+					Versions: []*smsV2.SecretVersion{{Id: 1, State: smsV2.VersionState_ENABLED}},
+				}, nil)
+			},
+			wantPayload: []byte(`{"id":"` + keyID + `","name":"very_secret","labels":{"env":"prod"},"versions":[{"id":1,"state":"ENABLED"}]}`),
+			wantErr:     nil,
+		},
+		{
+			name: "success_fetch_metadata_label",
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:            keyID,
+				Property:       "labels.env",
+				MetadataPolicy: esv1.ExternalSecretMetadataPolicyFetch,
+			},
+			setup: func(mock *fake.MockSecretProvider) {
+				mock.MockGetSecret(&smsV2.Secret{Id: keyID, Labels: map[string]string{"env": "prod"}}, nil)
+			},
+			wantPayload: []byte("prod"),
+			wantErr:     nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -27,6 +27,7 @@ import (
 type MockSecretProvider struct {
 	ListSecretsFns  []func() ([]*smsV2.Secret, error)
 	AccessSecretFns []func() ([]byte, error)
+	GetSecretFns    []func() (*smsV2.Secret, error)
 }
 
 func (m *MockSecretProvider) ListSecrets(_ context.Context, _ *adapter.ListSecretsRequest) ([]*smsV2.Secret, error) {
@@ -60,6 +61,24 @@ func (m *MockSecretProvider) AccessSecretVersion(_ context.Context, _, _ string)
 	return fn()
 }
 
+func (m *MockSecretProvider) GetSecretByPath(_ context.Context, _, _ string) (*smsV2.Secret, error) {
+	return m.nextGetSecret()
+}
+
+func (m *MockSecretProvider) GetSecret(_ context.Context, _ string) (*smsV2.Secret, error) {
+	return m.nextGetSecret()
+}
+
+func (m *MockSecretProvider) nextGetSecret() (*smsV2.Secret, error) {
+	fn := m.GetSecretFns[0]
+	if len(m.GetSecretFns) > 1 {
+		m.GetSecretFns = m.GetSecretFns[1:]
+	} else {
+		m.GetSecretFns = nil
+	}
+	return fn()
+}
+
 func (m *MockSecretProvider) MockListSecrets(list []*smsV2.Secret, err error) {
 	m.ListSecretsFns = append(m.ListSecretsFns, func() ([]*smsV2.Secret, error) { return list, err })
 }
@@ -72,4 +91,8 @@ func (m *MockSecretProvider) MockAccessSecretVersionPath(data []byte, err error)
 	m.AccessSecretFns = append(m.AccessSecretFns, func() ([]byte, error) { return data, err })
 }
 
+func (m *MockSecretProvider) MockGetSecret(secret *smsV2.Secret, err error) {
+	m.GetSecretFns = append(m.GetSecretFns, func() (*smsV2.Secret, error) { return secret, err })
+}
+
 func (m *MockSecretProvider) Close() error { return nil }
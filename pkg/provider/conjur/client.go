@@ -23,7 +23,6 @@ import (
 
 	"github.com/cyberark/conjur-api-go/conjurapi"
 	"github.com/cyberark/conjur-api-go/conjurapi/authn"
-	corev1 "k8s.io/api/core/v1"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,11 +33,12 @@ import (
 )
 
 var (
-	errConjurClient          = "cannot setup new Conjur client: %w"
-	errBadServiceUser        = "could not get Auth.Apikey.UserRef: %w"
-	errBadServiceAPIKey      = "could not get Auth.Apikey.ApiKeyRef: %w"
-	errGetKubeSATokenRequest = "cannot request Kubernetes service account token for service account %q: %w"
-	errSecretKeyFmt          = "cannot find secret data for key: %q"
+	errConjurClient           = "cannot setup new Conjur client: %w"
+	errBadServiceUser         = "could not get Auth.Apikey.UserRef: %w"
+	errBadServiceAPIKey       = "could not get Auth.Apikey.ApiKeyRef: %w"
+	errGetKubeSATokenRequest  = "cannot request Kubernetes service account token for service account %q: %w"
+	errSecretKeyFmt           = "cannot find secret data for key: %q"
+	errUnexpectedFindOperator = "unexpected find operator"
 )
 
 // Client is a provider for Conjur.
@@ -96,23 +96,6 @@ func (c *Client) GetConjurClient(ctx context.Context) (SecretsClient, error) {
 	return nil, errors.New("no authentication method provided")
 }
 
-// PushSecret will write a single secret into the provider.
-func (c *Client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1.PushSecretData) error {
-	// NOT IMPLEMENTED
-	return nil
-}
-
-// DeleteSecret removes a secret from the provider.
-func (c *Client) DeleteSecret(_ context.Context, _ esv1.PushSecretRemoteRef) error {
-	// NOT IMPLEMENTED
-	return nil
-}
-
-// SecretExists checks if a secret exists in the provider.
-func (c *Client) SecretExists(_ context.Context, _ esv1.PushSecretRemoteRef) (bool, error) {
-	return false, errors.New("not implemented")
-}
-
 // Validate validates the provider configuration.
 func (c *Client) Validate() (esv1.ValidationResult, error) {
 	return esv1.ValidationResultReady, nil
@@ -19,7 +19,10 @@ package conjur
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/cyberark/conjur-api-go/conjurapi"
@@ -29,6 +32,13 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/find"
 )
 
+const (
+	// pageSize is the number of resources listed per request.
+	pageSize = 100
+	// batchSize is the maximum number of variables fetched with a single batch request.
+	batchSize = 100
+)
+
 type conjurResource map[string]interface{}
 
 // resourceFilterFunc is a function that filters resources.
@@ -85,36 +95,35 @@ func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRe
 }
 
 // GetAllSecrets gets multiple secrets from the provider and loads into a kubernetes secret.
-// First load all secrets from secretStore path configuration
-// Then, gets secrets from a matching name or matching custom_metadata.
+// Variables are listed page by page and filtered by path prefix, name and annotations
+// (matched against find.tags). The values of all matching variables are then fetched
+// with the batch retrieval endpoint.
 func (c *Client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
-	if ref.Name != nil {
-		return c.findSecretsFromName(ctx, *ref.Name)
+	if ref.Name == nil && len(ref.Tags) == 0 {
+		return nil, errors.New(errUnexpectedFindOperator)
 	}
-	return c.findSecretsFromTags(ctx, ref.Tags)
-}
 
-func (c *Client) findSecretsFromName(ctx context.Context, ref esv1.FindName) (map[string][]byte, error) {
-	matcher, err := find.New(ref)
-	if err != nil {
-		return nil, err
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
 	}
 
 	var resourceFilterFunc = func(candidate conjurResource) (string, error) {
 		name := trimConjurResourceName(candidate["id"].(string))
-		isMatch := matcher.MatchName(name)
-		if !isMatch {
+		if ref.Path != nil && !strings.HasPrefix(name, *ref.Path) {
 			return "", nil
 		}
-		return name, nil
-	}
-
-	return c.listSecrets(ctx, resourceFilterFunc)
-}
+		if matcher != nil && !matcher.MatchName(name) {
+			return "", nil
+		}
+		if len(ref.Tags) == 0 {
+			return name, nil
+		}
 
-func (c *Client) findSecretsFromTags(ctx context.Context, tags map[string]string) (map[string][]byte, error) {
-	var resourceFilterFunc = func(candidate conjurResource) (string, error) {
-		name := trimConjurResourceName(candidate["id"].(string))
 		annotations, ok := candidate["annotations"].([]interface{})
 		if !ok {
 			// No annotations, skip
@@ -127,7 +136,7 @@ func (c *Client) findSecretsFromTags(ctx context.Context, tags map[string]string
 		}
 
 		// Check if all tags match
-		for tk, tv := range tags {
+		for tk, tv := range ref.Tags {
 			p, ok := formattedAnnotations[tk]
 			if !ok || p != tv {
 				return "", nil
@@ -154,10 +163,10 @@ func (c *Client) listSecrets(ctx context.Context, filterFunc resourceFilterFunc)
 	// in ESO, we will only load 100 secrets at a time. We will then filter these secrets,
 	// discarding any that do not match the filterFunc. We will then repeat this process
 	// until we have loaded all secrets.
-	for offset := 0; ; offset += 100 {
+	for offset := 0; ; offset += pageSize {
 		resFilter := &conjurapi.ResourceFilter{
 			Kind:   "variable",
-			Limit:  100,
+			Limit:  pageSize,
 			Offset: offset,
 		}
 		resources, err := conjurClient.Resources(resFilter)
@@ -175,19 +184,25 @@ func (c *Client) listSecrets(ctx context.Context, filterFunc resourceFilterFunc)
 			}
 		}
 
-		// If we have less than 100 resources, we reached the last page
-		if len(resources) < 100 {
+		// If we have less than a page of resources, we reached the last page
+		if len(resources) < pageSize {
 			break
 		}
 	}
 
-	filteredResources, err := c.client.RetrieveBatchSecrets(filteredResourceNames)
-	if err != nil {
-		return nil, err
+	// Fetch the values in chunks, so that the batch request URL stays within
+	// the limits of Conjur and any proxy in front of it.
+	secrets := make(map[string][]byte, len(filteredResourceNames))
+	for chunk := range slices.Chunk(filteredResourceNames, batchSize) {
+		filteredResources, err := conjurClient.RetrieveBatchSecrets(chunk)
+		if err != nil {
+			return nil, err
+		}
+		// Trim the resource names to just the last part of the ID
+		maps.Copy(secrets, trimConjurResourceNames(filteredResources))
 	}
 
-	// Trim the resource names to just the last part of the ID
-	return trimConjurResourceNames(filteredResources), nil
+	return secrets, nil
 }
 
 // trimConjurResourceNames trims the Conjur resource names to the last part of the ID.
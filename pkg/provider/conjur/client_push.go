@@ -0,0 +1,99 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conjur
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cyberark/conjur-api-go/conjurapi/response"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils"
+)
+
+// PushSecret sets the value of a Conjur variable.
+// The variable must already be declared in a Conjur policy, as variables
+// cannot be created through the secrets API.
+func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	value, err := esutils.ExtractSecretData(data, secret)
+	if err != nil {
+		return fmt.Errorf("failed to extract secret data: %w", err)
+	}
+
+	conjurClient, err := c.GetConjurClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	variableID := data.GetRemoteKey()
+	current, err := conjurClient.RetrieveSecret(variableID)
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+
+	if data.GetProperty() != "" {
+		if len(current) > 0 && !gjson.ValidBytes(current) {
+			return errors.New("PushSecret for conjur with a pushSecretData property requires a json secret")
+		}
+		value, err = sjson.SetBytes(current, data.GetProperty(), string(value))
+		if err != nil {
+			return fmt.Errorf("failed to set property %s: %w", data.GetProperty(), err)
+		}
+	}
+
+	if current != nil && bytes.Equal(current, value) {
+		return nil
+	}
+
+	return conjurClient.AddSecret(variableID, string(value))
+}
+
+// DeleteSecret is a no-op: removing a variable requires a policy change in Conjur,
+// which is out of scope for the secrets API.
+func (c *Client) DeleteSecret(_ context.Context, _ esv1.PushSecretRemoteRef) error {
+	return nil
+}
+
+// SecretExists checks if a variable with a value exists in Conjur.
+func (c *Client) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	conjurClient, err := c.GetConjurClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := conjurClient.RetrieveSecret(remoteRef.GetRemoteKey()); err != nil {
+		if isNotFoundErr(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isNotFoundErr reports whether Conjur responded that the variable
+// is not declared or has no value yet.
+func isNotFoundErr(err error) bool {
+	var conjurErr *response.ConjurError
+	return errors.As(err, &conjurErr) && conjurErr.Code == http.StatusNotFound
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conjur
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/provider/conjur/fake"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+func TestPushSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"password": []byte("new-value"),
+		},
+	}
+
+	cases := map[string]struct {
+		values     map[string][]byte
+		data       testingfake.PushSecretData
+		wantErr    string
+		wantValues map[string][]byte
+	}{
+		"SetsValueOfEmptyVariable": {
+			data: testingfake.PushSecretData{SecretKey: "password", RemoteKey: "missing"},
+			wantValues: map[string][]byte{
+				"missing": []byte("new-value"),
+			},
+		},
+		"UpdatesChangedValue": {
+			values: map[string][]byte{"app/db": []byte("old-value")},
+			data:   testingfake.PushSecretData{SecretKey: "password", RemoteKey: "app/db"},
+			wantValues: map[string][]byte{
+				"app/db": []byte("new-value"),
+			},
+		},
+		"MergesProperty": {
+			values: map[string][]byte{"app/db": []byte(`{"user":"admin"}`)},
+			data:   testingfake.PushSecretData{SecretKey: "password", RemoteKey: "app/db", Property: "password"},
+			wantValues: map[string][]byte{
+				"app/db": []byte(`{"user":"admin","password":"new-value"}`),
+			},
+		},
+		"RejectsPropertyOnNonJSONValue": {
+			values:  map[string][]byte{"app/db": []byte("plain")},
+			data:    testingfake.PushSecretData{SecretKey: "password", RemoteKey: "app/db", Property: "password"},
+			wantErr: "requires a json secret",
+		},
+		"MissingSecretKey": {
+			data:    testingfake.PushSecretData{SecretKey: "unknown", RemoteKey: "app/db"},
+			wantErr: "failed to find secret key",
+		},
+		"RetrieveError": {
+			data:    testingfake.PushSecretData{SecretKey: "password", RemoteKey: "error"},
+			wantErr: "error",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := &fake.ConjurMockClient{Values: tc.values}
+			c := &Client{client: mock}
+			err := c.PushSecret(context.Background(), secret, tc.data)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			for k, v := range tc.wantValues {
+				assert.Equal(t, string(v), string(mock.Values[k]))
+			}
+		})
+	}
+}
+
+func TestSecretExists(t *testing.T) {
+	mock := &fake.ConjurMockClient{}
+	c := &Client{client: mock}
+
+	exists, err := c.SecretExists(context.Background(), v1alpha1.PushSecretRemoteRef{RemoteKey: "app/db"})
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = c.SecretExists(context.Background(), v1alpha1.PushSecretRemoteRef{RemoteKey: "missing"})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = c.SecretExists(context.Background(), v1alpha1.PushSecretRemoteRef{RemoteKey: "error"})
+	assert.Error(t, err)
+}
@@ -26,6 +26,7 @@ type SecretsClient interface {
 	RetrieveSecret(secret string) (result []byte, err error)
 	RetrieveBatchSecrets(variableIDs []string) (map[string][]byte, error)
 	Resources(filter *conjurapi.ResourceFilter) (resources []map[string]interface{}, err error)
+	AddSecret(variableID string, secretValue string) error
 }
 
 // SecretsClientFactory is an interface for creating a Conjur client.
@@ -20,14 +20,26 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 
 	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/conjur-api-go/conjurapi/response"
 )
 
 type ConjurMockClient struct {
+	// Values overrides the values returned by RetrieveSecret and records values set by AddSecret.
+	Values map[string][]byte
 }
 
 func (mc *ConjurMockClient) RetrieveSecret(secret string) (result []byte, err error) {
+	if mc.Values != nil {
+		if v, ok := mc.Values[secret]; ok {
+			return v, nil
+		}
+	}
+	if secret == "missing" {
+		return nil, &response.ConjurError{Code: http.StatusNotFound, Message: "not found"}
+	}
 	if secret == "error" {
 		err = errors.New("error")
 		return nil, err
@@ -53,6 +65,17 @@ func (mc *ConjurMockClient) RetrieveBatchSecrets(variableIDs []string) (map[stri
 	return secrets, nil
 }
 
+func (mc *ConjurMockClient) AddSecret(variableID, secretValue string) error {
+	if variableID == "error" {
+		return errors.New("error")
+	}
+	if mc.Values == nil {
+		mc.Values = make(map[string][]byte)
+	}
+	mc.Values[variableID] = []byte(secretValue)
+	return nil
+}
+
 func (mc *ConjurMockClient) Resources(filter *conjurapi.ResourceFilter) (resources []map[string]interface{}, err error) {
 	policyID := "conjur:policy:root"
 	if filter.Offset == 0 {
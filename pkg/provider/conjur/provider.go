@@ -52,9 +52,9 @@ func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube
 }
 
 // Capabilities returns the provider's supported capabilities.
-// Conjur provider supports read-only access to secrets.
+// Conjur provider supports reading secrets and setting values of existing variables.
 func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
-	return esv1.SecretStoreReadOnly
+	return esv1.SecretStoreReadWrite
 }
 
 // newConjurProvider creates and returns a new Conjur client with the specified configuration.
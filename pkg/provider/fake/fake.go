@@ -191,6 +191,15 @@ func (p *Provider) GetSecret(_ context.Context, ref esv1.ExternalSecretDataRemot
 	return []byte(data.Value), nil
 }
 
+// GetSecretVersion returns the version of a secret, which is empty for secrets without a version.
+func (p *Provider) GetSecretVersion(_ context.Context, ref esv1.ExternalSecretDataRemoteRef) (string, error) {
+	data, ok := p.config[mapKey(ref.Key, ref.Version)]
+	if !ok || data.Version != ref.Version {
+		return "", esv1.NoSecretErr
+	}
+	return data.Version, nil
+}
+
 // GetSecretMap returns multiple k/v pairs from the provider.
 func (p *Provider) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
 	ddata, ok := p.config[mapKey(ref.Key, ref.Version)]
@@ -28,10 +28,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -113,6 +115,19 @@ func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube
 	return p.newClient(ctx, store, kube, clientset, namespace)
 }
 
+// NewGeneratorRESTConfig returns a rest config for the cluster described by the given provider spec.
+// Secrets and service accounts referenced by the spec are resolved in the given namespace.
+func (p *Provider) NewGeneratorRESTConfig(ctx context.Context, ctrlClient kclient.Client, ctrlClientset typedcorev1.CoreV1Interface, spec *esv1.KubernetesProvider, namespace string) (*rest.Config, error) {
+	client := &Client{
+		ctrlClientset: ctrlClientset,
+		ctrlClient:    ctrlClient,
+		store:         spec,
+		namespace:     namespace,
+		storeKind:     resolvers.EmptyStoreKind,
+	}
+	return client.getAuth(ctx)
+}
+
 func (p *Provider) newClient(ctx context.Context, store esv1.GenericStore, ctrlClient kclient.Client, ctrlClientset kubernetes.Interface, namespace string) (esv1.SecretsClient, error) {
 	storeSpec := store.GetSpec()
 	if storeSpec == nil || storeSpec.Provider == nil || storeSpec.Provider.Kubernetes == nil {
@@ -28,6 +28,8 @@ type OracleMockVaultClient struct {
 	CreatedCount    int
 	UpdatedCount    int
 	DeletedCount    int
+	LastCreate      vault.CreateSecretRequest
+	LastUpdate      vault.UpdateSecretRequest
 }
 
 func (o *OracleMockVaultClient) ListSecrets(_ context.Context, _ vault.ListSecretsRequest) (response vault.ListSecretsResponse, err error) {
@@ -36,13 +38,15 @@ func (o *OracleMockVaultClient) ListSecrets(_ context.Context, _ vault.ListSecre
 	}, nil
 }
 
-func (o *OracleMockVaultClient) CreateSecret(_ context.Context, _ vault.CreateSecretRequest) (response vault.CreateSecretResponse, err error) {
+func (o *OracleMockVaultClient) CreateSecret(_ context.Context, request vault.CreateSecretRequest) (response vault.CreateSecretResponse, err error) {
 	o.CreatedCount++
+	o.LastCreate = request
 	return vault.CreateSecretResponse{}, nil
 }
 
-func (o *OracleMockVaultClient) UpdateSecret(_ context.Context, _ vault.UpdateSecretRequest) (response vault.UpdateSecretResponse, err error) {
+func (o *OracleMockVaultClient) UpdateSecret(_ context.Context, request vault.UpdateSecretRequest) (response vault.UpdateSecretResponse, err error) {
 	o.UpdatedCount++
+	o.LastUpdate = request
 	return vault.UpdateSecretResponse{}, nil
 }
 
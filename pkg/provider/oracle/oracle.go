@@ -26,7 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -36,6 +36,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/secrets"
 	"github.com/oracle/oci-go-sdk/v65/vault"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,7 +46,9 @@ import (
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 	"github.com/external-secrets/external-secrets/pkg/esutils"
+	"github.com/external-secrets/external-secrets/pkg/esutils/metadata"
 	"github.com/external-secrets/external-secrets/pkg/esutils/resolvers"
+	"github.com/external-secrets/external-secrets/pkg/find"
 )
 
 const (
@@ -63,6 +66,7 @@ const (
 	errMissingKey                 = "missing Key in secret: %s"
 	errUnexpectedContent          = "unexpected secret bundle content"
 	errSettingOCIEnvVariables     = "unable to set OCI SDK environment variable %s: %w"
+	errUnexpectedFindOperator     = "unexpected find operator"
 )
 
 const (
@@ -112,29 +116,46 @@ const (
 	SecretAPIError
 )
 
+// PushSecretMetadataSpec contains metadata information for pushing secrets to Oracle Vault.
+type PushSecretMetadataSpec struct {
+	// Description is set on the secret when it is created or updated.
+	Description string `json:"description,omitempty"`
+	// FreeformTags are set on the secret when it is created or updated.
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+}
+
 // PushSecret creates or updates a secret in the Oracle Cloud Infrastructure Vault.
 func (vms *VaultManagementService) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
 	if vms.encryptionKey == "" {
 		return errors.New("SecretStore must reference encryption key")
 	}
-	value := secret.Data[data.GetSecretKey()]
-	if data.GetSecretKey() == "" {
-		secretData := map[string]string{}
-		for k, v := range secret.Data {
-			secretData[k] = string(v)
-		}
-		jsonSecret, err := json.Marshal(secretData)
-		if err != nil {
-			return fmt.Errorf("unable to create json %v from value: %v", value, secretData)
-		}
-		value = jsonSecret
+
+	value, err := esutils.ExtractSecretData(data, secret)
+	if err != nil {
+		return fmt.Errorf("failed to extract secret data: %w", err)
+	}
+
+	meta, err := metadata.ParseMetadataParameters[PushSecretMetadataSpec](data.GetMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to parse push secret metadata: %w", err)
+	}
+	if meta == nil {
+		meta = &metadata.PushSecretMetadata[PushSecretMetadataSpec]{}
+	}
+	var description *string
+	if meta.Spec.Description != "" {
+		description = &meta.Spec.Description
 	}
 
 	secretName := data.GetRemoteKey()
-	encodedValue := base64.StdEncoding.EncodeToString(value)
 	sec, action, err := vms.getSecretBundleWithCode(ctx, secretName)
 	switch action {
 	case SecretNotFound:
+		value, err = mergeProperty(value, data.GetProperty(), nil)
+		if err != nil {
+			return err
+		}
+		encodedValue := base64.StdEncoding.EncodeToString(value)
 		_, err = vms.VaultClient.CreateSecret(ctx, vault.CreateSecretRequest{
 			CreateSecretDetails: vault.CreateSecretDetails{
 				CompartmentId: &vms.compartment,
@@ -142,8 +163,10 @@ func (vms *VaultManagementService) PushSecret(ctx context.Context, secret *corev
 				SecretContent: vault.Base64SecretContentDetails{
 					Content: &encodedValue,
 				},
-				SecretName: &secretName,
-				VaultId:    &vms.vault,
+				SecretName:   &secretName,
+				VaultId:      &vms.vault,
+				Description:  description,
+				FreeformTags: meta.Spec.FreeformTags,
 			},
 		})
 		return sanitizeOCISDKErr(err)
@@ -152,15 +175,22 @@ func (vms *VaultManagementService) PushSecret(ctx context.Context, secret *corev
 		if err != nil {
 			return err
 		}
+		value, err = mergeProperty(value, data.GetProperty(), payload)
+		if err != nil {
+			return err
+		}
 		if bytes.Equal(payload, value) {
 			return nil
 		}
+		encodedValue := base64.StdEncoding.EncodeToString(value)
 		_, err = vms.VaultClient.UpdateSecret(ctx, vault.UpdateSecretRequest{
 			SecretId: sec.SecretId,
 			UpdateSecretDetails: vault.UpdateSecretDetails{
 				SecretContent: vault.Base64SecretContentDetails{
 					Content: &encodedValue,
 				},
+				Description:  description,
+				FreeformTags: meta.Spec.FreeformTags,
 			},
 		})
 		return sanitizeOCISDKErr(err)
@@ -169,7 +199,23 @@ func (vms *VaultManagementService) PushSecret(ctx context.Context, secret *corev
 	}
 }
 
-// DeleteSecret removes a secret from the Oracle Cloud Infrastructure Vault.
+// mergeProperty sets value as the given property of the JSON document in current.
+// Without a property the value replaces the whole secret.
+func mergeProperty(value []byte, property string, current []byte) ([]byte, error) {
+	if property == "" {
+		return value, nil
+	}
+	if len(current) > 0 && !gjson.ValidBytes(current) {
+		return nil, errors.New("PushSecret for oracle vault with a pushSecretData property requires a json secret")
+	}
+	merged, err := sjson.SetBytes(current, property, string(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set property %s: %w", property, err)
+	}
+	return merged, nil
+}
+
+// DeleteSecret schedules the deletion of a secret in the Oracle Cloud Infrastructure Vault.
 func (vms *VaultManagementService) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
 	secretName := remoteRef.GetRemoteKey()
 	resp, action, err := vms.getSecretBundleWithCode(ctx, secretName)
@@ -190,20 +236,34 @@ func (vms *VaultManagementService) DeleteSecret(ctx context.Context, remoteRef e
 }
 
 // SecretExists checks if a secret exists in the Oracle Cloud Infrastructure Vault.
-func (vms *VaultManagementService) SecretExists(_ context.Context, _ esv1.PushSecretRemoteRef) (bool, error) {
-	return false, errors.New("not implemented")
+// Secrets that are scheduled for deletion are reported as missing.
+func (vms *VaultManagementService) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	resp, action, err := vms.getSecretBundleWithCode(ctx, remoteRef.GetRemoteKey())
+	switch action {
+	case SecretNotFound:
+		return false, nil
+	case SecretExists:
+		return resp.TimeOfDeletion == nil, nil
+	default:
+		return false, sanitizeOCISDKErr(err)
+	}
 }
 
 // GetAllSecrets retrieves all secrets from the Oracle Cloud Infrastructure Vault that match the given criteria.
 func (vms *VaultManagementService) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	if ref.Name == nil && len(ref.Tags) == 0 {
+		return nil, errors.New(errUnexpectedFindOperator)
+	}
+
 	var page *string
 	var summaries []vault.SecretSummary
 
 	for {
 		resp, err := vms.VaultClient.ListSecrets(ctx, vault.ListSecretsRequest{
-			CompartmentId: &vms.compartment,
-			Page:          page,
-			VaultId:       &vms.vault,
+			CompartmentId:  &vms.compartment,
+			Page:           page,
+			VaultId:        &vms.vault,
+			LifecycleState: vault.SecretSummaryLifecycleStateActive,
 		})
 		if err != nil {
 			return nil, sanitizeOCISDKErr(err)
@@ -280,7 +340,7 @@ func (vms *VaultManagementService) GetSecretMap(ctx context.Context, ref esv1.Ex
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
 func (vms *VaultManagementService) Capabilities() esv1.SecretStoreCapabilities {
-	return esv1.SecretStoreReadOnly
+	return esv1.SecretStoreReadWrite
 }
 
 // NewClient constructs a new secrets client based on the provided store.
@@ -386,13 +446,18 @@ func getSecretBundleCode(err error) int {
 }
 
 func (vms *VaultManagementService) filteredSummaryResult(ctx context.Context, secretSummaries []vault.SecretSummary, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
-	secretMap := map[string][]byte{}
-	for _, summary := range secretSummaries {
-		matches, err := matchesRef(summary, ref)
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
 		if err != nil {
 			return nil, err
 		}
-		if !matches || summary.TimeOfDeletion != nil {
+		matcher = m
+	}
+
+	secretMap := map[string][]byte{}
+	for _, summary := range secretSummaries {
+		if !matchesRef(summary, ref, matcher) || summary.TimeOfDeletion != nil {
 			continue
 		}
 		secret, err := vms.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{
@@ -406,22 +471,22 @@ func (vms *VaultManagementService) filteredSummaryResult(ctx context.Context, se
 	return secretMap, nil
 }
 
-func matchesRef(secretSummary vault.SecretSummary, ref esv1.ExternalSecretFind) (bool, error) {
-	if ref.Name != nil {
-		matchString, err := regexp.MatchString(ref.Name.RegExp, *secretSummary.SecretName)
-		if err != nil {
-			return false, err
-		}
-		return matchString, nil
+// matchesRef reports whether the secret matches the path prefix, the name regexp
+// and all of the freeform tags of the find operator.
+func matchesRef(secretSummary vault.SecretSummary, ref esv1.ExternalSecretFind, matcher *find.Matcher) bool {
+	name := esutils.Deref(secretSummary.SecretName)
+	if ref.Path != nil && !strings.HasPrefix(name, *ref.Path) {
+		return false
+	}
+	if matcher != nil && !matcher.MatchName(name) {
+		return false
 	}
 	for k, v := range ref.Tags {
-		if val, ok := secretSummary.FreeformTags[k]; ok {
-			if val == v {
-				return true, nil
-			}
+		if val, ok := secretSummary.FreeformTags[k]; !ok || val != v {
+			return false
 		}
 	}
-	return false, nil
+	return true
 }
 
 func getSecretData(ctx context.Context, kube kclient.Client, namespace, storeKind string, secretRef esmeta.SecretKeySelector) (string, error) {
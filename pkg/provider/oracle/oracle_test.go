@@ -34,6 +34,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/vault"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -570,6 +571,83 @@ func TestOracleVaultGetAllSecrets(t *testing.T) {
 				s2id: []byte(s2id),
 			},
 		},
+		"matches all freeform tags": {
+			&VaultManagementService{
+				Client: &fakeoracle.OracleMockClient{
+					SecretBundles: map[string]secrets.SecretBundle{
+						s1id: s1bundle,
+						s2id: s2bundle,
+					},
+				},
+				VaultClient: &fakeoracle.OracleMockVaultClient{
+					SecretSummaries: []vault.SecretSummary{
+						withTags(s1summary, map[string]string{"env": "prod", "team": "a"}),
+						withTags(s2summary, map[string]string{"env": "prod", "team": "b"}),
+					},
+				},
+			},
+			esv1.ExternalSecretFind{
+				Tags: map[string]string{
+					"env":  "prod",
+					"team": "b",
+				},
+			},
+			map[string][]byte{
+				s2id: []byte(s2id),
+			},
+		},
+		"matches name and tags": {
+			&VaultManagementService{
+				Client: &fakeoracle.OracleMockClient{
+					SecretBundles: map[string]secrets.SecretBundle{
+						s1id: s1bundle,
+						s2id: s2bundle,
+					},
+				},
+				VaultClient: &fakeoracle.OracleMockVaultClient{
+					SecretSummaries: []vault.SecretSummary{
+						withTags(s1summary, map[string]string{"env": "prod"}),
+						withTags(s2summary, map[string]string{"env": "prod"}),
+					},
+				},
+			},
+			esv1.ExternalSecretFind{
+				Name: &esv1.FindName{
+					RegExp: "^my",
+				},
+				Tags: map[string]string{
+					"env": "prod",
+				},
+			},
+			map[string][]byte{
+				s2id: []byte(s2id),
+			},
+		},
+		"filters secrets outside of path": {
+			&VaultManagementService{
+				Client: &fakeoracle.OracleMockClient{
+					SecretBundles: map[string]secrets.SecretBundle{
+						s1id: s1bundle,
+						s2id: s2bundle,
+					},
+				},
+				VaultClient: &fakeoracle.OracleMockVaultClient{
+					SecretSummaries: []vault.SecretSummary{
+						s1summary,
+						s2summary,
+					},
+				},
+			},
+			esv1.ExternalSecretFind{
+				Path: ptr.To("test"),
+				Name: &esv1.FindName{
+					RegExp: ".*",
+				},
+			},
+			map[string][]byte{
+				s1id: []byte(s1id),
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -668,6 +746,49 @@ func TestOracleVaultPushSecret(t *testing.T) {
 			},
 			s1id,
 		},
+		"create a secret with metadata": {
+			&VaultManagementService{
+				encryptionKey: encryptionKey,
+				Client: &fakeoracle.OracleMockClient{
+					SecretBundles: map[string]secrets.SecretBundle{},
+				},
+				VaultClient: &fakeoracle.OracleMockVaultClient{},
+			},
+			testingfake.PushSecretData{
+				SecretKey: testSecretKey,
+				RemoteKey: s1id,
+				Metadata: &apiextensionsv1.JSON{
+					Raw: []byte(`{"apiVersion":"kubernetes.external-secrets.io/v1alpha1","kind":"PushSecretMetadata","spec":{"description":"pushed","freeformTags":{"env":"prod"}}}`),
+				},
+			},
+			func(vms *VaultManagementService) bool {
+				created := vms.VaultClient.(*fakeoracle.OracleMockVaultClient).LastCreate.CreateSecretDetails
+				return ptr.Deref(created.Description, "") == "pushed" &&
+					reflect.DeepEqual(created.FreeformTags, map[string]string{"env": "prod"})
+			},
+			"created",
+		},
+		"update a property of an existing json secret": {
+			&VaultManagementService{
+				encryptionKey: encryptionKey,
+				Client: &fakeoracle.OracleMockClient{
+					SecretBundles: map[string]secrets.SecretBundle{
+						s1id: makeSecretBundleWithContent(s1id, `{"other":"value"}`),
+					},
+				},
+				VaultClient: &fakeoracle.OracleMockVaultClient{},
+			},
+			testingfake.PushSecretData{
+				SecretKey: testSecretKey,
+				RemoteKey: s1id,
+				Property:  "prop",
+			},
+			func(vms *VaultManagementService) bool {
+				content := vms.VaultClient.(*fakeoracle.OracleMockVaultClient).LastUpdate.SecretContent.(vault.Base64SecretContentDetails).Content
+				return *content == base64.StdEncoding.EncodeToString([]byte(`{"other":"value","prop":"updated"}`))
+			},
+			"updated",
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -745,6 +866,32 @@ func TestOracleVaultDeleteSecret(t *testing.T) {
 	}
 }
 
+func TestOracleVaultSecretExists(t *testing.T) {
+	vms := &VaultManagementService{
+		Client: &fakeoracle.OracleMockClient{
+			SecretBundles: map[string]secrets.SecretBundle{
+				s1id: s1bundle,
+				s3id: s3bundle,
+			},
+		},
+	}
+	var testCases = map[string]struct {
+		remoteKey string
+		exists    bool
+	}{
+		"existing secret":             {s1id, true},
+		"missing secret":              {s2id, false},
+		"secret scheduled for delete": {s3id, false},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			exists, err := vms.SecretExists(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: testCase.remoteKey})
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.exists, exists)
+		})
+	}
+}
+
 var (
 	s1id      = "test1"
 	s2id      = "mysecret"
@@ -773,6 +920,19 @@ func makeSecretBundle(id string, deleting bool) secrets.SecretBundle {
 	}
 }
 
+func makeSecretBundleWithContent(id, content string) secrets.SecretBundle {
+	bundle := makeSecretBundle(id, false)
+	bundle.SecretBundleContent = secrets.Base64SecretBundleContentDetails{
+		Content: ptr.To(base64.StdEncoding.EncodeToString([]byte(content))),
+	}
+	return bundle
+}
+
+func withTags(summary vault.SecretSummary, tags map[string]string) vault.SecretSummary {
+	summary.FreeformTags = tags
+	return summary
+}
+
 func makeSecretSummary(id string, deleting bool) vault.SecretSummary {
 	var deletionTime *common.SDKTime
 	if deleting {
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -35,7 +36,10 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/find"
 )
 
-var errNoSecretForName = errors.New("no secret for this name")
+var (
+	errNoSecretForName = errors.New("no secret for this name")
+	errInvalidVersion  = errors.New("invalid version: should be a revision number, 'latest' or 'latest_enabled'")
+)
 
 type client struct {
 	api       secretAPI
@@ -47,6 +51,9 @@ const (
 	refTypeName = "name"
 	refTypeID   = "id"
 	refTypePath = "path"
+
+	versionLatest        = "latest"
+	versionLatestEnabled = "latest_enabled"
 )
 
 type scwSecretRef struct {
@@ -76,12 +83,20 @@ func (c *client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemot
 		return nil, err
 	}
 
-	versionSpec := "latest_enabled"
+	versionSpec := versionLatestEnabled
 	if ref.Version != "" {
 		versionSpec = ref.Version
 	}
+	if !isValidVersion(versionSpec) {
+		return nil, errInvalidVersion
+	}
 
-	value, err := c.accessSecretVersion(ctx, scwRef, versionSpec)
+	var value []byte
+	if ref.MetadataPolicy == esv1.ExternalSecretMetadataPolicyFetch {
+		value, err = c.secretMetadata(ctx, scwRef)
+	} else {
+		value, err = c.accessSecretVersion(ctx, scwRef, versionSpec)
+	}
 	if err != nil {
 		//nolint:errorlint
 		if _, isNotFoundErr := err.(*scw.ResourceNotFoundError); isNotFoundErr {
@@ -329,6 +344,7 @@ func (c *client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind)
 		}
 	}
 
+	// Scaleway tags are plain strings, so only the keys of ref.Tags are used.
 	for tag := range ref.Tags {
 		request.Tags = append(request.Tags, tag)
 	}
@@ -350,6 +366,9 @@ func (c *client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind)
 			if nameMatcher != nil && !nameMatcher.MatchName(secret.Name) {
 				continue
 			}
+			if !hasAllTags(secret.Tags, request.Tags) {
+				continue
+			}
 
 			accessReq := smapi.AccessSecretVersionRequest{
 				Region:   secret.Region,
@@ -395,14 +414,7 @@ func (c *client) accessSecretVersion(ctx context.Context, secretRef *scwSecretRe
 	}
 
 	// otherwise, we do a GetSecret() first to avoid transferring the secret value if it is cached
-	request := &smapi.ListSecretsRequest{
-		ProjectID: &c.projectID,
-		Page:      scw.Int32Ptr(1),
-		PageSize:  scw.Uint32Ptr(1),
-	}
-
-	switch secretRef.RefType {
-	case refTypeID:
+	if secretRef.RefType == refTypeID {
 		request := smapi.GetSecretVersionRequest{
 			SecretID: secretRef.Value,
 			Revision: versionSpec,
@@ -412,6 +424,37 @@ func (c *client) accessSecretVersion(ctx context.Context, secretRef *scwSecretRe
 			return nil, err
 		}
 		return c.accessSpecificSecretVersion(ctx, response.SecretID, response.Revision)
+	}
+
+	secret, err := c.getSecretByRef(ctx, secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	secretID := secret.ID
+
+	secretVersion, err := c.api.GetSecretVersion(&smapi.GetSecretVersionRequest{
+		SecretID: secretID,
+		Revision: versionSpec,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.accessSpecificSecretVersion(ctx, secretID, secretVersion.Revision)
+}
+
+// getSecretByRef returns the secret referenced by id, name or path.
+func (c *client) getSecretByRef(ctx context.Context, secretRef *scwSecretRef) (*smapi.Secret, error) {
+	request := &smapi.ListSecretsRequest{
+		ProjectID: &c.projectID,
+		Page:      scw.Int32Ptr(1),
+		PageSize:  scw.Uint32Ptr(1),
+	}
+
+	switch secretRef.RefType {
+	case refTypeID:
+		return c.api.GetSecret(&smapi.GetSecretRequest{SecretID: secretRef.Value}, scw.WithContext(ctx))
 	case refTypeName:
 		request.Name = &secretRef.Value
 
@@ -436,17 +479,17 @@ func (c *client) accessSecretVersion(ctx context.Context, secretRef *scwSecretRe
 		return nil, errNoSecretForName
 	}
 
-	secretID := response.Secrets[0].ID
+	return response.Secrets[0], nil
+}
 
-	secretVersion, err := c.api.GetSecretVersion(&smapi.GetSecretVersionRequest{
-		SecretID: secretID,
-		Revision: versionSpec,
-	}, scw.WithContext(ctx))
+// secretMetadata returns the metadata of the referenced secret (tags, description, path, version count...) as JSON.
+func (c *client) secretMetadata(ctx context.Context, secretRef *scwSecretRef) ([]byte, error) {
+	secret, err := c.getSecretByRef(ctx, secretRef)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.accessSpecificSecretVersion(ctx, secretID, secretVersion.Revision)
+	return json.Marshal(secret)
 }
 
 func (c *client) accessSpecificSecretVersion(ctx context.Context, secretID string, revision uint32) ([]byte, error) {
@@ -488,6 +531,27 @@ func extractJSONProperty(secretData []byte, property string) ([]byte, error) {
 	return jsonToSecretData(json.RawMessage(result.Raw)), nil
 }
 
+// isValidVersion reports whether the version is a revision number or one of the
+// 'latest' and 'latest_enabled' keywords.
+func isValidVersion(version string) bool {
+	if version == versionLatest || version == versionLatestEnabled {
+		return true
+	}
+
+	_, err := strconv.ParseUint(version, 10, 32)
+	return err == nil
+}
+
+func hasAllTags(secretTags, requiredTags []string) bool {
+	for _, tag := range requiredTags {
+		if !slices.Contains(secretTags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func splitNameAndPath(ref string) (name, path string, ok bool) {
 	if !strings.HasPrefix(ref, "/") {
 		return
@@ -152,6 +152,36 @@ func TestGetSecret(t *testing.T) {
 			},
 			response: secret.versions[0].data,
 		},
+		"asking for latest enabled version by name": {
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:     "name:" + secret.name,
+				Version: "latest_enabled",
+			},
+			response: secret.versions[1].data,
+		},
+		"invalid version should yield an error": {
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:     "name:" + secret.name,
+				Version: "v1",
+			},
+			err: errInvalidVersion,
+		},
+		"fetching metadata by name": {
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:            "name:secret-2",
+				Property:       "tags",
+				MetadataPolicy: esv1.ExternalSecretMetadataPolicyFetch,
+			},
+			response: []byte(`["secret-2-tag-1","secret-2-tag-2"]`),
+		},
+		"fetching metadata by id": {
+			ref: esv1.ExternalSecretDataRemoteRef{
+				Key:            "id:" + secret.id,
+				Property:       "name",
+				MetadataPolicy: esv1.ExternalSecretMetadataPolicyFetch,
+			},
+			response: []byte(secret.name),
+		},
 		"asking for nested json property": {
 			ref: esv1.ExternalSecretDataRemoteRef{
 				Key:      "id:" + db.secret("json-nested").id,
@@ -379,6 +409,12 @@ func TestGetAllSecrets(t *testing.T) {
 				db.secrets[1].name: db.secrets[1].mustGetVersion("latest").data,
 			},
 		},
+		"find secrets by tags requires all tags": {
+			ref: esv1.ExternalSecretFind{
+				Tags: map[string]string{"secret-2-tag-1": "", "missing-tag": ""},
+			},
+			response: map[string][]byte{},
+		},
 		"find secrets by path": {
 			ref: esv1.ExternalSecretFind{
 				Path: esutils.Ptr("/subpath"),
@@ -18,6 +18,7 @@ limitations under the License.
 package template
 
 import (
+	"bytes"
 	"crypto/x509"
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
@@ -57,3 +58,12 @@ func jwkPrivateKeyPem(jwkjson string) (string, error) {
 	}
 	return pemEncode(mpk, "PRIVATE KEY")
 }
+
+// ParseJWK parses a key which is either encoded as JWK or as PEM.
+func ParseJWK(data []byte) (jwk.Key, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("-----BEGIN")) {
+		return jwk.ParseKey(data, jwk.WithPEM(true))
+	}
+	return jwk.ParseKey(data)
+}
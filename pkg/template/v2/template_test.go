@@ -17,6 +17,7 @@ limitations under the License.
 package template
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -975,3 +976,19 @@ func TestPkcs12certPass(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJWK(t *testing.T) {
+	fromJWK, err := ParseJWK([]byte(jwkPrivRSA))
+	require.NoError(t, err)
+	fromPEM, err := ParseJWK([]byte("\n" + jwkPrivRSAPKCS8))
+	require.NoError(t, err)
+
+	want, err := fromJWK.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	got, err := fromPEM.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = ParseJWK([]byte("not a key"))
+	assert.Error(t, err)
+}